@@ -0,0 +1,160 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// The aggregate functions below ignore nil elements, like aggregate
+// functions in SQL ignore NULL, and return nil if src contains no
+// non-nil elements. Unlike e.g. IntSlice, they do not treat nil as 0,
+// which would skew averages. Avg, Median and StdDev compute in float64.
+
+// Sum returns the sum of the non-nil elements of src.
+func Sum[T Number](src []*T) *T {
+	var (
+		sum T
+		n   int
+	)
+	for _, v := range src {
+		if v != nil {
+			sum += *v
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	return &sum
+}
+
+// Count returns the number of non-nil elements of src.
+func Count[T any](src []*T) int {
+	n := 0
+	for _, v := range src {
+		if v != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Avg returns the arithmetic mean of the non-nil elements of src.
+func Avg[T Number](src []*T) *float64 {
+	var (
+		sum float64
+		n   int
+	)
+	for _, v := range src {
+		if v != nil {
+			sum += float64(*v)
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	avg := sum / float64(n)
+	return &avg
+}
+
+// MinOf returns the smallest non-nil element of src.
+// The name avoids a conflict with Min, which compares two values.
+func MinOf[T cmp.Ordered](src []*T) *T {
+	return extremum(src, func(x, y T) bool { return x < y })
+}
+
+// MaxOf returns the largest non-nil element of src.
+// The name avoids a conflict with Max, which compares two values.
+func MaxOf[T cmp.Ordered](src []*T) *T {
+	return extremum(src, func(x, y T) bool { return x > y })
+}
+
+func extremum[T any](src []*T, better func(T, T) bool) *T {
+	var res *T
+	for _, v := range src {
+		if v != nil && (res == nil || better(*v, *res)) {
+			res = v
+		}
+	}
+	return res
+}
+
+// Median returns the median of the non-nil elements of src.
+// For an even number of elements, it returns the mean of the
+// two middle elements.
+func Median[T Number](src []*T) *float64 {
+	values := make([]float64, 0, len(src))
+	for _, v := range src {
+		if v != nil {
+			values = append(values, float64(*v))
+		}
+	}
+	n := len(values)
+	if n == 0 {
+		return nil
+	}
+	slices.Sort(values)
+	median := values[n/2]
+	if n%2 == 0 {
+		median = (values[n/2-1] + values[n/2]) / 2
+	}
+	return &median
+}
+
+// StdDev returns the sample standard deviation of the non-nil elements
+// of src, like STDDEV in SQL. It returns nil if src contains less than
+// two non-nil elements.
+func StdDev[T Number](src []*T) *float64 {
+	// Welford's online algorithm
+	var (
+		mean, m2 float64
+		n        int
+	)
+	for _, v := range src {
+		if v == nil {
+			continue
+		}
+		n++
+		x := float64(*v)
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+	if n < 2 {
+		return nil
+	}
+	stddev := math.Sqrt(m2 / float64(n-1))
+	return &stddev
+}
+
+// DurationAvg is like Avg but returns a duration, rounded to
+// the nearest nanosecond.
+func DurationAvg(src []*time.Duration) *time.Duration {
+	return durationOf(Avg(src))
+}
+
+// DurationMedian is like Median but returns a duration, rounded to
+// the nearest nanosecond.
+func DurationMedian(src []*time.Duration) *time.Duration {
+	return durationOf(Median(src))
+}
+
+// DurationStdDev is like StdDev but returns a duration, rounded to
+// the nearest nanosecond.
+func DurationStdDev(src []*time.Duration) *time.Duration {
+	return durationOf(StdDev(src))
+}
+
+func durationOf(v *float64) *time.Duration {
+	if v == nil {
+		return nil
+	}
+	return DurationPtr(time.Duration(math.Round(*v)))
+}
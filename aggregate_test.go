@@ -0,0 +1,145 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAggregatesInt(t *testing.T) {
+	tests := []struct {
+		Input  []*int
+		Sum    *int
+		Count  int
+		Avg    *float64
+		Min    *int
+		Max    *int
+		Median *float64
+		StdDev *float64
+	}{
+		{
+			Input: nil,
+		},
+		{
+			Input: []*int{nil, nil},
+		},
+		{
+			Input:  []*int{nil, IntPtr(3)},
+			Sum:    IntPtr(3),
+			Count:  1,
+			Avg:    Float64Ptr(3),
+			Min:    IntPtr(3),
+			Max:    IntPtr(3),
+			Median: Float64Ptr(3),
+		},
+		{
+			Input:  []*int{IntPtr(2), nil, IntPtr(4), IntPtr(4), nil, IntPtr(4), IntPtr(5), IntPtr(5), IntPtr(7), IntPtr(9)},
+			Sum:    IntPtr(40),
+			Count:  8,
+			Avg:    Float64Ptr(5),
+			Min:    IntPtr(2),
+			Max:    IntPtr(9),
+			Median: Float64Ptr(4.5),
+			StdDev: Float64Ptr(math.Sqrt(32.0 / 7)),
+		},
+		{
+			Input:  []*int{IntPtr(1), nil, IntPtr(-2), IntPtr(10)},
+			Sum:    IntPtr(9),
+			Count:  3,
+			Avg:    Float64Ptr(3),
+			Min:    IntPtr(-2),
+			Max:    IntPtr(10),
+			Median: Float64Ptr(1),
+			StdDev: Float64Ptr(math.Sqrt(39)),
+		},
+	}
+
+	for i, tt := range tests {
+		if have, want := Sum(tt.Input), tt.Sum; !Equal(have, want) {
+			t.Errorf("#%d: have Sum(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := Count(tt.Input), tt.Count; have != want {
+			t.Errorf("#%d: have Count(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := Avg(tt.Input), tt.Avg; !floatEqual(have, want) {
+			t.Errorf("#%d: have Avg(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := MinOf(tt.Input), tt.Min; !Equal(have, want) {
+			t.Errorf("#%d: have MinOf(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := MaxOf(tt.Input), tt.Max; !Equal(have, want) {
+			t.Errorf("#%d: have MaxOf(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := Median(tt.Input), tt.Median; !floatEqual(have, want) {
+			t.Errorf("#%d: have Median(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := StdDev(tt.Input), tt.StdDev; !floatEqual(have, want) {
+			t.Errorf("#%d: have StdDev(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestAggregatesFloat32(t *testing.T) {
+	input := []*float32{Float32Ptr(1.5), nil, Float32Ptr(2.5), Float32Ptr(-1)}
+	if have, want := Sum(input), Float32Ptr(3); !Equal(have, want) {
+		t.Errorf("have Sum = %v, want %v", have, want)
+	}
+	if have, want := Avg(input), Float64Ptr(1); !floatEqual(have, want) {
+		t.Errorf("have Avg = %v, want %v", have, want)
+	}
+	if have, want := MinOf(input), Float32Ptr(-1); !Equal(have, want) {
+		t.Errorf("have MinOf = %v, want %v", have, want)
+	}
+	if have, want := Median(input), Float64Ptr(1.5); !floatEqual(have, want) {
+		t.Errorf("have Median = %v, want %v", have, want)
+	}
+}
+
+func TestAggregatesInt64AndFloat64(t *testing.T) {
+	ints := []*int64{nil, Int64Ptr(1 << 40), Int64Ptr(1 << 40)}
+	if have, want := Sum(ints), Int64Ptr(1<<41); !Equal(have, want) {
+		t.Errorf("have Sum = %v, want %v", have, want)
+	}
+	if have, want := StdDev(ints), Float64Ptr(0); !floatEqual(have, want) {
+		t.Errorf("have StdDev = %v, want %v", have, want)
+	}
+	floats := []*float64{Float64Ptr(0.5), nil, Float64Ptr(0.25)}
+	if have, want := MaxOf(floats), Float64Ptr(0.5); !Equal(have, want) {
+		t.Errorf("have MaxOf = %v, want %v", have, want)
+	}
+	if have, want := Avg(floats), Float64Ptr(0.375); !floatEqual(have, want) {
+		t.Errorf("have Avg = %v, want %v", have, want)
+	}
+}
+
+func TestAggregatesDuration(t *testing.T) {
+	input := []*time.Duration{DurationPtr(time.Second), nil, DurationPtr(2 * time.Second), DurationPtr(6 * time.Second)}
+	if have, want := Sum(input), DurationPtr(9*time.Second); !Equal(have, want) {
+		t.Errorf("have Sum = %v, want %v", have, want)
+	}
+	if have, want := MinOf(input), DurationPtr(time.Second); !Equal(have, want) {
+		t.Errorf("have MinOf = %v, want %v", have, want)
+	}
+	if have, want := DurationAvg(input), DurationPtr(3*time.Second); !Equal(have, want) {
+		t.Errorf("have DurationAvg = %v, want %v", have, want)
+	}
+	if have, want := DurationMedian(input), DurationPtr(2*time.Second); !Equal(have, want) {
+		t.Errorf("have DurationMedian = %v, want %v", have, want)
+	}
+	if have, want := DurationStdDev(input), DurationPtr(time.Duration(math.Round(math.Sqrt(7)*1e9))); !Equal(have, want) {
+		t.Errorf("have DurationStdDev = %v, want %v", have, want)
+	}
+	if have := DurationAvg([]*time.Duration{nil}); have != nil {
+		t.Errorf("have DurationAvg([nil]) = %v, want nil", have)
+	}
+}
+
+func floatEqual(a, b *float64) bool {
+	return EqualFunc(a, b, func(x, y float64) bool {
+		return math.Abs(x-y) <= 1e-9*math.Max(1, math.Abs(y))
+	})
+}
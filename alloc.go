@@ -0,0 +1,84 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// defaultChunkSize is the number of values per chunk of an Allocator
+// created without an explicit chunk size.
+const defaultChunkSize = 1024
+
+// Allocator creates pointers to values of type T in bulk. Instead of
+// allocating each value separately, like e.g. IntPtr, it stores the
+// values in chunks of chunkSize values, reducing the number of heap
+// allocations when creating many pointers, e.g. for a batch of DTOs.
+//
+// Notice that a chunk is not garbage collected as long as there is a
+// pointer to any of its values.
+//
+// The zero value is an Allocator ready to use with a default chunk size.
+// An Allocator is not safe for concurrent use.
+type Allocator[T any] struct {
+	chunkSize int
+	chunks    [][]T
+	cur       int // index of the current chunk in chunks
+	n         int // number of used values in the current chunk
+}
+
+// NewAllocator returns an Allocator with chunks of chunkSize values.
+// If chunkSize is not positive, a default chunk size is used.
+func NewAllocator[T any](chunkSize int) *Allocator[T] {
+	return &Allocator[T]{chunkSize: chunkSize}
+}
+
+// Ptr returns a pointer to a copy of v, like e.g. IntPtr.
+func (a *Allocator[T]) Ptr(v T) *T {
+	if len(a.chunks) == 0 || a.n == len(a.chunks[a.cur]) {
+		a.nextChunk()
+	}
+	p := &a.chunks[a.cur][a.n]
+	*p = v
+	a.n++
+	return p
+}
+
+// PtrSlice returns a slice of pointers to copies of the values in src.
+// Unlike e.g. IntPtrSlice, the pointers do not point into src.
+func (a *Allocator[T]) PtrSlice(src []T) []*T {
+	dst := make([]*T, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = a.Ptr(src[i])
+	}
+	return dst
+}
+
+// Reset makes the Allocator reuse its chunks for subsequent calls to
+// Ptr. The pointers returned before must no longer be used, as their
+// values are cleared and then overwritten.
+func (a *Allocator[T]) Reset() {
+	for i := 0; i < len(a.chunks) && i <= a.cur; i++ {
+		clear(a.chunks[i])
+	}
+	a.cur, a.n = 0, 0
+}
+
+// nextChunk makes the next chunk the current one,
+// allocating it if necessary.
+func (a *Allocator[T]) nextChunk() {
+	switch {
+	case len(a.chunks) == 0:
+		a.cur = 0
+	case a.cur+1 < len(a.chunks):
+		a.cur++
+		a.n = 0
+		return
+	default:
+		a.cur++
+	}
+	size := a.chunkSize
+	if size <= 0 {
+		size = defaultChunkSize
+	}
+	a.chunks = append(a.chunks, make([]T, size))
+	a.n = 0
+}
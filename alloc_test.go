@@ -0,0 +1,184 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAllocator(t *testing.T) {
+	a := NewAllocator[int](3)
+	var ptrs []*int
+	for i := 0; i < 10; i++ {
+		ptrs = append(ptrs, a.Ptr(i))
+	}
+	for i, p := range ptrs {
+		if p == nil || *p != i {
+			t.Fatalf("#%d: have *Ptr(%d) = %v, want %d", i, i, p, i)
+		}
+	}
+	if have, want := len(a.chunks), 4; have != want {
+		t.Errorf("have %d chunks, want %d", have, want)
+	}
+
+	// Pointers must be distinct.
+	*ptrs[0] = 42
+	for i := 1; i < len(ptrs); i++ {
+		if *ptrs[i] != i {
+			t.Errorf("#%d: have %d after modifying another pointer, want %d", i, *ptrs[i], i)
+		}
+	}
+}
+
+func TestAllocatorZeroValue(t *testing.T) {
+	var a Allocator[string]
+	p := a.Ptr("one")
+	if p == nil || *p != "one" {
+		t.Fatalf("have Ptr(%q) = %v, want %q", "one", p, "one")
+	}
+	if have, want := len(a.chunks[0]), defaultChunkSize; have != want {
+		t.Errorf("have chunk size %d, want %d", have, want)
+	}
+}
+
+func TestAllocatorReset(t *testing.T) {
+	a := NewAllocator[string](2)
+	for i := 0; i < 5; i++ {
+		a.Ptr(strconv.Itoa(i))
+	}
+	if have, want := len(a.chunks), 3; have != want {
+		t.Fatalf("have %d chunks, want %d", have, want)
+	}
+	first := &a.chunks[0][0]
+
+	a.Reset()
+	for i, chunk := range a.chunks {
+		for j, v := range chunk {
+			if v != "" {
+				t.Errorf("have chunks[%d][%d] = %q after Reset, want it to be cleared", i, j, v)
+			}
+		}
+	}
+
+	// Reuse the chunks after Reset.
+	for i := 0; i < 6; i++ {
+		p := a.Ptr(strconv.Itoa(i))
+		if i == 0 && p != first {
+			t.Errorf("expected Ptr to reuse the first chunk after Reset")
+		}
+		if *p != strconv.Itoa(i) {
+			t.Errorf("#%d: have *Ptr = %q, want %q", i, *p, strconv.Itoa(i))
+		}
+	}
+	if have, want := len(a.chunks), 3; have != want {
+		t.Errorf("have %d chunks after Reset, want %d", have, want)
+	}
+	a.Ptr("6")
+	if have, want := len(a.chunks), 4; have != want {
+		t.Errorf("have %d chunks, want %d", have, want)
+	}
+}
+
+func TestAllocatorPtrSlice(t *testing.T) {
+	a := NewAllocator[time.Duration](0)
+	src := []time.Duration{time.Second, time.Minute}
+	have := a.PtrSlice(src)
+	if len(have) != len(src) {
+		t.Fatalf("have len(PtrSlice(%v)) = %d, want %d", src, len(have), len(src))
+	}
+	for i := 0; i < len(src); i++ {
+		if *have[i] != src[i] {
+			t.Errorf("have PtrSlice(%v)[%d] = %v, want %v", src, i, *have[i], src[i])
+		}
+		if have[i] == &src[i] {
+			t.Errorf("expected PtrSlice(%v)[%d] to not point into src", src, i)
+		}
+	}
+}
+
+func TestAllocatorSteadyStateDoesNotAllocate(t *testing.T) {
+	a := NewAllocator[int](100)
+	allocs := testing.AllocsPerRun(10, func() {
+		a.Reset()
+		for i := 0; i < 1000; i++ {
+			a.Ptr(i)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("have %v allocations in steady state, want 0", allocs)
+	}
+}
+
+const benchmarkBatchSize = 100000
+
+func BenchmarkIntPtr(b *testing.B) {
+	ptrs := make([]*int, benchmarkBatchSize)
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = IntPtr(i)
+		}
+	}
+}
+
+func BenchmarkAllocatorInt(b *testing.B) {
+	ptrs := make([]*int, benchmarkBatchSize)
+	a := NewAllocator[int](4096)
+	b.ReportAllocs()
+	for b.Loop() {
+		a.Reset()
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = a.Ptr(i)
+		}
+	}
+}
+
+func BenchmarkStringPtr(b *testing.B) {
+	ptrs := make([]*string, benchmarkBatchSize)
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = StringPtr("value")
+		}
+	}
+}
+
+func BenchmarkAllocatorString(b *testing.B) {
+	ptrs := make([]*string, benchmarkBatchSize)
+	a := NewAllocator[string](4096)
+	b.ReportAllocs()
+	for b.Loop() {
+		a.Reset()
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = a.Ptr("value")
+		}
+	}
+}
+
+func BenchmarkTimePtr(b *testing.B) {
+	ptrs := make([]*time.Time, benchmarkBatchSize)
+	now := time.Now()
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = TimePtr(now)
+		}
+	}
+}
+
+func BenchmarkAllocatorTime(b *testing.B) {
+	ptrs := make([]*time.Time, benchmarkBatchSize)
+	now := time.Now()
+	a := NewAllocator[time.Time](4096)
+	b.ReportAllocs()
+	for b.Loop() {
+		a.Reset()
+		for i := 0; i < len(ptrs); i++ {
+			ptrs[i] = a.Ptr(now)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+// Package nilderef defines an Analyzer that reports dereferences of
+// optional (pointer) struct fields that are not preceded by a nil check.
+//
+// For example, given
+//
+//	type Book struct {
+//		Year *int
+//	}
+//
+// the analyzer reports *book.Year unless it is guarded by a nil check
+// of book.Year, e.g. in the body of if book.Year != nil { ... }, and
+// suggests to replace it with nullable.Int(book.Year).
+package nilderef
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const importPath = "github.com/olivere/nullable"
+
+const doc = `check for dereferences of optional fields without a nil check
+
+The nilderef analyzer reports expressions like *v.Field, where Field is
+a pointer to a type supported by package nullable, that are not guarded
+by a nil check of v.Field in the same function, i.e. that are not in the
+body of if v.Field != nil, after if v.Field == nil { return }, or right of
+v.Field != nil &&. It suggests to
+replace the dereference with the corresponding nullable function, e.g.
+nullable.Int(v.Field), which returns the zero value for nil.`
+
+// Analyzer reports unchecked dereferences of optional fields.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nilderef",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/olivere/nullable/analysis/nilderef",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// funcs maps the types supported by package nullable to the name
+// of the function that returns the value or its zero value.
+var funcs = map[string]string{
+	"int":           "Int",
+	"int32":         "Int32",
+	"int64":         "Int64",
+	"float32":       "Float32",
+	"float64":       "Float64",
+	"string":        "String",
+	"bool":          "Bool",
+	"time.Time":     "Time",
+	"time.Duration": "Duration",
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.StarExpr)(nil),
+	}
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		star := n.(*ast.StarExpr)
+		if tv, ok := pass.TypesInfo.Types[star]; !ok || !tv.IsValue() {
+			return true // e.g. a type expression like *int
+		}
+		sel, ok := ast.Unparen(star.X).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		fn := funcFor(pass.TypesInfo, sel)
+		if fn == "" {
+			return true
+		}
+		if isWrite(pass.TypesInfo, stack) {
+			return true
+		}
+		if isGuarded(pass, sel, stack) {
+			return true
+		}
+
+		field := exprString(pass.Fset, sel)
+		pass.Report(analysis.Diagnostic{
+			Pos:     star.Pos(),
+			End:     star.End(),
+			Message: fmt.Sprintf("dereference of optional field %s without nil check", field),
+			SuggestedFixes: []analysis.SuggestedFix{
+				suggestedFix(pass, star, fn, field),
+			},
+		})
+		return true
+	})
+	return nil, nil
+}
+
+// funcFor returns the name of the nullable function for the field
+// selected by sel, or "" if sel is not a field of a supported pointer type.
+func funcFor(info *types.Info, sel *ast.SelectorExpr) string {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return ""
+	}
+	ptr, ok := types.Unalias(selection.Type()).(*types.Pointer)
+	if !ok {
+		return ""
+	}
+	switch elem := types.Unalias(ptr.Elem()).(type) {
+	case *types.Basic:
+		return funcs[elem.Name()]
+	case *types.Named:
+		obj := elem.Obj()
+		if obj.Pkg() == nil || obj.Pkg().Path() != "time" {
+			return ""
+		}
+		return funcs["time."+obj.Name()]
+	}
+	return ""
+}
+
+// isWrite returns true if star, the top of stack, is the target of an
+// assignment or only its address is used, as in *v.Field = 1, &*v.Field
+// or (*v.Field).PointerMethod(). Parentheses around star are ignored.
+func isWrite(info *types.Info, stack []ast.Node) bool {
+	i := len(stack) - 2
+	for ; i > 0; i-- {
+		if _, ok := stack[i].(*ast.ParenExpr); !ok {
+			break
+		}
+	}
+	child := stack[i+1]
+	switch parent := stack[i].(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == child {
+				return true
+			}
+		}
+	case *ast.IncDecStmt:
+		return parent.X == child
+	case *ast.UnaryExpr:
+		return parent.Op == token.AND
+	case *ast.RangeStmt:
+		return parent.Key == child || parent.Value == child
+	case *ast.SelectorExpr:
+		// Calling a method with a pointer receiver takes the address
+		selection, ok := info.Selections[parent]
+		if !ok || selection.Kind() != types.MethodVal {
+			return false
+		}
+		sig, ok := selection.Obj().Type().(*types.Signature)
+		if !ok || sig.Recv() == nil {
+			return false
+		}
+		_, ptrRecv := types.Unalias(sig.Recv().Type()).(*types.Pointer)
+		return ptrRecv
+	}
+	return false
+}
+
+// isGuarded returns true if the dereference at the top of stack is only
+// evaluated if sel is not nil, i.e. if it is
+//
+//   - in the body of if sel != nil { ... },
+//   - in the else branch of if sel == nil { ... } else { ... },
+//   - right of sel != nil && ... or sel == nil || ...,
+//   - after if sel == nil { ...; return } or if sel == nil { sel = ... }
+//     in the same or an enclosing block, without sel being assigned
+//     in between.
+//
+// Only the innermost function is taken into account.
+func isGuarded(pass *analysis.Pass, sel *ast.SelectorExpr, stack []ast.Node) bool {
+	g := guard{info: pass.TypesInfo, fset: pass.Fset, want: exprString(pass.Fset, sel)}
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch parent := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if child == parent.Body && g.implies(parent.Cond, true) {
+				return true
+			}
+			if child == parent.Else && g.implies(parent.Cond, false) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != parent.Y {
+				break
+			}
+			if parent.Op == token.LAND && g.implies(parent.X, true) {
+				return true
+			}
+			if parent.Op == token.LOR && g.implies(parent.X, false) {
+				return true
+			}
+		case *ast.BlockStmt:
+			// Look for the closest early exit, unless the
+			// expression is assigned in between.
+			j := slices.Index(parent.List, child.(ast.Stmt))
+			for j--; j >= 0; j-- {
+				if g.isEarlyExit(parent.List[j]) {
+					return true
+				}
+				if g.isAssigned(parent.List[j]) {
+					return false
+				}
+			}
+		}
+	}
+	return false
+}
+
+// guard checks whether conditions imply that an expression is not nil.
+type guard struct {
+	info *types.Info
+	fset *token.FileSet
+	want string // the expression, e.g. b.Year
+}
+
+// isAssigned returns true if stmt assigns the expression or,
+// e.g. for b.Year, the expression it is selected from.
+func (g guard) isAssigned(stmt ast.Stmt) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				s := exprString(g.fset, ast.Unparen(lhs))
+				if s == g.want || strings.HasPrefix(g.want, s+".") {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// implies returns true if cond evaluating to truth implies
+// that the expression is not nil.
+func (g guard) implies(cond ast.Expr, truth bool) bool {
+	switch e := ast.Unparen(cond).(type) {
+	case *ast.UnaryExpr:
+		return e.Op == token.NOT && g.implies(e.X, !truth)
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.LAND:
+			return truth && (g.implies(e.X, true) || g.implies(e.Y, true))
+		case token.LOR:
+			return !truth && (g.implies(e.X, false) || g.implies(e.Y, false))
+		case token.EQL, token.NEQ:
+			if !g.isNilComparison(e) {
+				return false
+			}
+			return (e.Op == token.NEQ) == truth
+		}
+	}
+	return false
+}
+
+// isNilComparison returns true if e compares the expression to nil.
+func (g guard) isNilComparison(e *ast.BinaryExpr) bool {
+	var other ast.Expr
+	switch {
+	case isNil(g.info, e.X):
+		other = e.Y
+	case isNil(g.info, e.Y):
+		other = e.X
+	default:
+		return false
+	}
+	return exprString(g.fset, ast.Unparen(other)) == g.want
+}
+
+// isEarlyExit returns true if stmt is an if statement without else
+// that is only left normally if the expression is not nil, e.g.
+// if v == nil { return } or if v == nil { v = new(int) }.
+func (g guard) isEarlyExit(stmt ast.Stmt) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || ifStmt.Else != nil || !g.implies(ifStmt.Cond, false) {
+		return false
+	}
+	list := ifStmt.Body.List
+	if len(list) == 0 {
+		return false
+	}
+	switch last := list[len(list)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := ast.Unparen(last.X).(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok {
+			return false
+		}
+		b, ok := g.info.Uses[id].(*types.Builtin)
+		return ok && b.Name() == "panic"
+	case *ast.AssignStmt:
+		for i, lhs := range last.Lhs {
+			if exprString(g.fset, ast.Unparen(lhs)) == g.want {
+				return len(last.Lhs) != len(last.Rhs) || !isNil(g.info, last.Rhs[i])
+			}
+		}
+	}
+	return false
+}
+
+func isNil(info *types.Info, e ast.Expr) bool {
+	tv, ok := info.Types[e]
+	return ok && tv.IsNil()
+}
+
+// suggestedFix replaces star with a call to the nullable function fn,
+// adding an import of package nullable if necessary.
+func suggestedFix(pass *analysis.Pass, star *ast.StarExpr, fn, field string) analysis.SuggestedFix {
+	file := fileOf(pass, star.Pos())
+	name, imported := localName(file)
+	call := fn + "(" + field + ")"
+	if name != "." {
+		call = name + "." + call
+	}
+	edits := []analysis.TextEdit{{
+		Pos:     star.Pos(),
+		End:     star.End(),
+		NewText: []byte(call),
+	}}
+	if !imported {
+		edits = append(edits, importEdit(pass.Fset, file))
+	}
+	return analysis.SuggestedFix{
+		Message:   "Replace with nullable." + fn,
+		TextEdits: edits,
+	}
+}
+
+// importEdit returns the edit that adds an import of package nullable
+// to file. It adds the import to the first import declaration as a
+// separate group, e.g.
+//
+//	import (
+//		"fmt"
+//
+//		"github.com/olivere/nullable"
+//	)
+func importEdit(fset *token.FileSet, file *ast.File) analysis.TextEdit {
+	path := strconv.Quote(importPath)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			return analysis.TextEdit{
+				Pos:     gen.Rparen,
+				End:     gen.Rparen,
+				NewText: []byte("\n\t" + path + "\n"),
+			}
+		}
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, gen.Specs[0])
+		return analysis.TextEdit{
+			Pos:     gen.Pos(),
+			End:     gen.End(),
+			NewText: []byte("import (\n\t" + buf.String() + "\n\n\t" + path + "\n)"),
+		}
+	}
+	return analysis.TextEdit{
+		Pos:     file.Name.End(),
+		End:     file.Name.End(),
+		NewText: []byte("\n\nimport " + path),
+	}
+}
+
+// localName returns the name under which package nullable is
+// imported in file, and whether it is imported at all.
+func localName(file *ast.File) (string, bool) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != importPath {
+			continue
+		}
+		if spec.Name == nil {
+			return "nullable", true
+		}
+		if spec.Name.Name != "_" {
+			return spec.Name.Name, true
+		}
+	}
+	return "nullable", false
+}
+
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos < f.FileEnd {
+			return f
+		}
+	}
+	return nil
+}
+
+func exprString(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, e)
+	return buf.String()
+}
@@ -0,0 +1,163 @@
+package a
+
+import (
+	"fmt"
+	"time"
+)
+
+type Book struct {
+	Title     string
+	Year      *int
+	Pages     *int64
+	Price     *float64
+	Subtitle  *string
+	Available *bool
+	Published *time.Time
+	Runtime   *time.Duration
+	Authors   *[]string
+}
+
+func unchecked(b Book) {
+	fmt.Println(*b.Year)      // want `dereference of optional field b.Year without nil check`
+	fmt.Println(*b.Pages)     // want `dereference of optional field b.Pages without nil check`
+	fmt.Println(*b.Price)     // want `dereference of optional field b.Price without nil check`
+	fmt.Println(*b.Subtitle)  // want `dereference of optional field b.Subtitle without nil check`
+	fmt.Println(*b.Available) // want `dereference of optional field b.Available without nil check`
+	fmt.Println(*b.Published) // want `dereference of optional field b.Published without nil check`
+	fmt.Println(*b.Runtime)   // want `dereference of optional field b.Runtime without nil check`
+	fmt.Println(*b.Authors)   // not supported by package nullable
+}
+
+func checked(b *Book) int {
+	if b.Year != nil {
+		fmt.Println(*b.Year)
+	}
+	if b.Subtitle == nil {
+		return 0
+	}
+	fmt.Println(*b.Subtitle)
+	if b.Pages != nil && *b.Pages > 100 {
+		return 1
+	}
+	return 2
+}
+
+func checkedTooLate(b *Book) {
+	fmt.Println(*b.Price) // want `dereference of optional field b.Price without nil check`
+	if b.Price != nil {
+		return
+	}
+}
+
+func writes(b *Book, year int) {
+	*b.Year = year
+	*b.Pages++
+	p := &*b.Price
+	_ = p
+}
+
+func parenthesizedWrites(b *Book, year int, data []byte) error {
+	(*b.Year) = year
+	(*b.Pages)++
+	(*b.Subtitle) = "x"
+	p := &(*b.Price)
+	_ = p
+	fmt.Println((*b.Published).Year()) // want `dereference of optional field b.Published without nil check`
+	return (*b.Published).UnmarshalJSON(data)
+}
+
+func closure(b *Book) func() int {
+	if b.Year == nil {
+		return nil
+	}
+	return func() int {
+		return *b.Year // want `dereference of optional field b.Year without nil check`
+	}
+}
+
+func locals(year *int) int {
+	return *year // only fields are reported
+}
+
+func wrongBranch(b *Book) {
+	if b.Year == nil {
+		fmt.Println(*b.Year) // want `dereference of optional field b.Year without nil check`
+	}
+	if b.Pages != nil {
+		return
+	}
+	fmt.Println(*b.Pages)               // want `dereference of optional field b.Pages without nil check`
+	if b.Price != nil || *b.Price > 0 { // want `dereference of optional field b.Price without nil check`
+		return
+	}
+}
+
+func unrelatedCheck(b *Book) {
+	if b.Year != nil {
+		fmt.Println("has year")
+	}
+	fmt.Println(*b.Year) // want `dereference of optional field b.Year without nil check`
+}
+
+func otherGuards(b *Book) {
+	if b.Year == nil {
+		fmt.Println("no year")
+	} else {
+		fmt.Println(*b.Year)
+	}
+	if b.Price == nil || *b.Price == 0 {
+		fmt.Println("free")
+	}
+	if !(b.Available == nil) {
+		fmt.Println(*b.Available)
+	}
+	if b.Runtime == nil {
+		b.Runtime = new(time.Duration)
+	}
+	fmt.Println(*b.Runtime)
+	for _, d := range []int{1, 2} {
+		if b.Pages == nil {
+			continue
+		}
+		fmt.Println(*b.Pages + int64(d))
+	}
+	if b.Published == nil {
+		panic("unpublished")
+	}
+	fmt.Println(*b.Published)
+}
+
+type Count = int
+
+type CountPtr = *int
+
+type Stats struct {
+	Copies *Count
+	Sold   CountPtr
+}
+
+func aliases(s Stats) {
+	fmt.Println(*s.Copies) // want `dereference of optional field s.Copies without nil check`
+	fmt.Println(*s.Sold)   // want `dereference of optional field s.Sold without nil check`
+}
+
+func reassigned(b, other *Book) {
+	if b.Year == nil {
+		return
+	}
+	b.Year = nil
+	fmt.Println(*b.Year) // want `dereference of optional field b.Year without nil check`
+	if b.Pages == nil {
+		return
+	}
+	b = other
+	fmt.Println(*b.Pages) // want `dereference of optional field b.Pages without nil check`
+	if b.Price != nil {
+		b.Price = nil
+		fmt.Println(*b.Price) // want `dereference of optional field b.Price without nil check`
+	}
+	if b.Subtitle == nil {
+		b.Subtitle = new(string)
+	}
+	fmt.Println(*b.Subtitle)
+}
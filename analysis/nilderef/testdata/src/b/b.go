@@ -0,0 +1,16 @@
+package b
+
+import nl "github.com/olivere/nullable"
+
+type Config struct {
+	Name *string
+	Port *int
+}
+
+func port(c Config) int {
+	return nl.Int(c.Port) + *c.Port // want `dereference of optional field c.Port without nil check`
+}
+
+func name(c *Config) string {
+	return *(c.Name) // want `dereference of optional field c.Name without nil check`
+}
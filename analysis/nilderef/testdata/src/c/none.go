@@ -0,0 +1,5 @@
+package c
+
+func total(o Order, price int) int {
+	return *o.Quantity * price // want `dereference of optional field o.Quantity without nil check`
+}
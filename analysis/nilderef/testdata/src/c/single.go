@@ -0,0 +1,11 @@
+package c
+
+import "fmt"
+
+type Order struct {
+	Quantity *int
+}
+
+func quantity(o Order) {
+	fmt.Println(*o.Quantity) // want `dereference of optional field o.Quantity without nil check`
+}
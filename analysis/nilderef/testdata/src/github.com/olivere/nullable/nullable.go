@@ -0,0 +1,5 @@
+package nullable
+
+func Int(v *int) int { return 0 }
+
+func String(v *string) string { return "" }
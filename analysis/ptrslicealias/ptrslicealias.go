@@ -0,0 +1,270 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+// Package ptrslicealias defines an Analyzer that reports modifications
+// of a slice after it has been passed to one of the XPtrSlice or
+// AppendXPtrSlice functions of package nullable.
+//
+// Functions like nullable.IntPtrSlice return pointers into the slice
+// passed to them, so the results change if the elements of the source
+// slice are modified afterwards, e.g.
+//
+//	ptrs := nullable.IntPtrSlice(values)
+//	values[0] = 42 // also changes *ptrs[0]
+//
+// Appending to the source slice is reported, too, as the results then
+// may or may not alias the elements of the source slice, depending
+// on whether append had to reallocate.
+package ptrslicealias
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const importPath = "github.com/olivere/nullable"
+
+const doc = `check for modifications of slices passed to nullable.XPtrSlice
+
+The ptrslicealias analyzer reports assignments to elements of a slice,
+and appending to, copying into or clearing a slice, after the slice has been
+passed to one of the XPtrSlice or AppendXPtrSlice functions of package
+nullable (e.g. nullable.IntPtrSlice) or to nullable.PtrIfNonZeroSlice in
+the same function. The pointers returned by these functions alias the
+elements of the source slice until the variable holding the slice is
+assigned a different slice. In loops, modifications before the call are
+reported, too, as they affect the pointers of the previous iteration.`
+
+// Analyzer reports modifications of slices whose elements are
+// aliased by the result of an XPtrSlice function.
+var Analyzer = &analysis.Analyzer{
+	Name:     "ptrslicealias",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/olivere/nullable/analysis/ptrslicealias",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.FuncLit)(nil),
+	}
+	reported := make(map[ast.Node]bool)
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var body *ast.BlockStmt
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			body = fn.Body
+		case *ast.FuncLit:
+			body = fn.Body
+		}
+		if body == nil {
+			return
+		}
+		ast.Inspect(body, func(n ast.Node) bool {
+			if _, ok := n.(*ast.FuncLit); ok {
+				return false // checked separately
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			fn := ptrSliceFunc(pass.TypesInfo, call)
+			if fn == nil {
+				return true
+			}
+			// The source slice is the last argument, e.g. in
+			// IntPtrSlice(src) as well as in AppendIntPtrSlice(dst, src).
+			id, ok := ast.Unparen(call.Args[len(call.Args)-1]).(*ast.Ident)
+			if !ok {
+				return true
+			}
+			v, ok := pass.TypesInfo.Uses[id].(*types.Var)
+			if !ok {
+				return true
+			}
+			for _, m := range mutations(pass.TypesInfo, body, v, call) {
+				if reported[m.node] {
+					continue
+				}
+				reported[m.node] = true
+				pass.Reportf(m.node.Pos(),
+					"%s %s after nullable.%s(%s); the returned pointers alias its elements",
+					m.what, id.Name, fn.Name(), argsString(call.Args))
+			}
+			return true
+		})
+	})
+	return nil, nil
+}
+
+// aliasingFuncs lists the functions of package nullable, besides
+// XPtrSlice and AppendXPtrSlice, that return pointers into their
+// last argument.
+var aliasingFuncs = map[string]bool{
+	"PtrIfNonZeroSlice": true,
+}
+
+// ptrSliceFunc returns the function of package nullable called by call
+// if it returns pointers into its last argument, or nil. Methods like
+// Interner.StringPtrSlice are not taken into account, as they do not
+// alias their argument.
+func ptrSliceFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != importPath {
+		return nil
+	}
+	if fn.Signature().Recv() != nil {
+		return nil
+	}
+	if !strings.HasSuffix(fn.Name(), "PtrSlice") && !aliasingFuncs[fn.Name()] {
+		return nil
+	}
+	return fn
+}
+
+// argsString returns the arguments of a call as in the source.
+func argsString(args []ast.Expr) string {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		s[i] = types.ExprString(arg)
+	}
+	return strings.Join(s, ", ")
+}
+
+type mutation struct {
+	node ast.Node
+	what string
+}
+
+// mutations returns the statements and expressions in body that modify
+// v while it is aliased by the result of call, i.e. after call and before
+// v is assigned a different slice. If call is in a loop, modifications
+// before call in the loop are taken into account, too, as they are
+// executed after call in the next iteration.
+func mutations(info *types.Info, body *ast.BlockStmt, v *types.Var, call *ast.CallExpr) []mutation {
+	refersToV := func(e ast.Expr) bool {
+		id, ok := ast.Unparen(e).(*ast.Ident)
+		return ok && info.Uses[id] == v
+	}
+	isElemOfV := func(e ast.Expr) bool {
+		idx, ok := ast.Unparen(e).(*ast.IndexExpr)
+		return ok && refersToV(idx.X)
+	}
+	isBuiltin := func(call *ast.CallExpr, name string) bool {
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok || id.Name != name {
+			return false
+		}
+		_, ok = info.Uses[id].(*types.Builtin)
+		return ok
+	}
+	// derivesFromV returns true if e may share the elements of v,
+	// as in append(v, 1) or v[1:].
+	derivesFromV := func(e ast.Expr) bool {
+		switch e := ast.Unparen(e).(type) {
+		case *ast.CallExpr:
+			return isBuiltin(e, "append") && len(e.Args) > 0 && refersToV(e.Args[0])
+		case *ast.SliceExpr:
+			return refersToV(e.X)
+		}
+		return refersToV(e)
+	}
+
+	// Find the outermost loop containing call.
+	var loop ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if loop != nil || n == nil || n.Pos() > call.Pos() || n.End() < call.End() {
+			return false
+		}
+		switch n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			loop = n
+			return false
+		}
+		return true
+	})
+
+	var res []mutation
+	var reassigned []token.Pos
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == call {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			// v is declared in a loop, e.g. var v = make([]int, 3)
+			for _, name := range n.Names {
+				if info.Defs[name] == v {
+					reassigned = append(reassigned, n.Pos())
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				id, ok := ast.Unparen(lhs).(*ast.Ident)
+				if !ok || (info.Uses[id] != v && info.Defs[id] != v) {
+					continue
+				}
+				if len(n.Lhs) != len(n.Rhs) || !derivesFromV(n.Rhs[i]) {
+					reassigned = append(reassigned, n.Pos())
+				}
+			}
+			for _, lhs := range n.Lhs {
+				if isElemOfV(lhs) {
+					res = append(res, mutation{n, "assignment to element of"})
+					break
+				}
+			}
+		case *ast.IncDecStmt:
+			if isElemOfV(n.X) {
+				res = append(res, mutation{n, "assignment to element of"})
+			}
+		case *ast.CallExpr:
+			if len(n.Args) == 0 || !refersToV(n.Args[0]) {
+				break
+			}
+			switch {
+			case isBuiltin(n, "append"):
+				res = append(res, mutation{n, "append to"})
+			case isBuiltin(n, "copy"):
+				res = append(res, mutation{n, "copy into"})
+			case isBuiltin(n, "clear"):
+				res = append(res, mutation{n, "clear of"})
+			}
+		}
+		return true
+	})
+
+	// reassignedIn returns true if v is assigned a different slice
+	// in [from, to).
+	reassignedIn := func(from, to token.Pos) bool {
+		for _, pos := range reassigned {
+			if from <= pos && pos < to {
+				return true
+			}
+		}
+		return false
+	}
+	return slices.DeleteFunc(res, func(m mutation) bool {
+		pos := m.node.Pos()
+		switch {
+		case pos >= call.End():
+			return reassignedIn(call.End(), pos)
+		case loop != nil && pos >= loop.Pos():
+			return reassignedIn(loop.Pos(), pos) || reassignedIn(call.End(), loop.End())
+		}
+		return true
+	})
+}
@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func appendInt(dst []*int, values []int) []*int {
+	dst = nullable.AppendIntPtrSlice(dst, values)
+	values[0] = 1 // want `assignment to element of values after nullable.AppendIntPtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendInt32(dst []*int32, values []int32) []*int32 {
+	dst = nullable.AppendInt32PtrSlice(dst, values)
+	values = append(values, 1) // want `append to values after nullable.AppendInt32PtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendInt64(dst []*int64, values []int64) []*int64 {
+	dst = nullable.AppendInt64PtrSlice(dst, values)
+	values[0]++ // want `assignment to element of values after nullable.AppendInt64PtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendFloat32(dst []*float32, values []float32) []*float32 {
+	dst = nullable.AppendFloat32PtrSlice(dst, values)
+	clear(values) // want `clear of values after nullable.AppendFloat32PtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendFloat64(dst []*float64, values, other []float64) []*float64 {
+	dst = nullable.AppendFloat64PtrSlice(dst, values)
+	copy(values, other) // want `copy into values after nullable.AppendFloat64PtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendString(dst []*string, values []string) []*string {
+	dst = nullable.AppendStringPtrSlice(dst, values)
+	values[0] = "x" // want `assignment to element of values after nullable.AppendStringPtrSlice\(dst, values\); the returned pointers alias its elements`
+	return dst
+}
+
+func appendDst(dst []*int, values []int, p *int) []*int {
+	dst = nullable.AppendIntPtrSlice(dst, values)
+	dst[0] = p // dst is not the source
+	return dst
+}
@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func float32Append(values []float32) []*float32 {
+	ptrs := nullable.Float32PtrSlice(values)
+	values = append(values, 1.5) // want `append to values after nullable.Float32PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func float32Assign(values []float32) []*float32 {
+	ptrs := nullable.Float32PtrSlice(values)
+	for i := range values {
+		values[i] = 1.5 // want `assignment to element of values after nullable.Float32PtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func float32Copy(values, other []float32) []*float32 {
+	ptrs := nullable.Float32PtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.Float32PtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.Float32PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func float32Before(values []float32) []*float32 {
+	values[0] = 1.5
+	values = append(values, 1.5)
+	return nullable.Float32PtrSlice(values)
+}
+
+func float32Other(values, other []float32) []*float32 {
+	ptrs := nullable.Float32PtrSlice(values)
+	other[0] = 1.5
+	other = append(other, values...)
+	return ptrs
+}
+
+func float32Closure(values []float32) func() []*float32 {
+	return func() []*float32 {
+		ptrs := nullable.Float32PtrSlice(values)
+		values[0] = 1.5 // want `assignment to element of values after nullable.Float32PtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
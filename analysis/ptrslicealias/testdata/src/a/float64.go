@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func float64Append(values []float64) []*float64 {
+	ptrs := nullable.Float64PtrSlice(values)
+	values = append(values, 1.5) // want `append to values after nullable.Float64PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func float64Assign(values []float64) []*float64 {
+	ptrs := nullable.Float64PtrSlice(values)
+	for i := range values {
+		values[i] = 1.5 // want `assignment to element of values after nullable.Float64PtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func float64Copy(values, other []float64) []*float64 {
+	ptrs := nullable.Float64PtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.Float64PtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.Float64PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func float64Before(values []float64) []*float64 {
+	values[0] = 1.5
+	values = append(values, 1.5)
+	return nullable.Float64PtrSlice(values)
+}
+
+func float64Other(values, other []float64) []*float64 {
+	ptrs := nullable.Float64PtrSlice(values)
+	other[0] = 1.5
+	other = append(other, values...)
+	return ptrs
+}
+
+func float64Closure(values []float64) func() []*float64 {
+	return func() []*float64 {
+		ptrs := nullable.Float64PtrSlice(values)
+		values[0] = 1.5 // want `assignment to element of values after nullable.Float64PtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
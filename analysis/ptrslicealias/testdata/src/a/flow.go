@@ -0,0 +1,62 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func reassigned(values []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	values = make([]int, 3)
+	values[0] = 1 // values no longer aliases ptrs
+	return ptrs
+}
+
+func reslicedIsStillAliased(values []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	values = values[1:]
+	values[0] = 1 // want `assignment to element of values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func loop(values []int) []*int {
+	var ptrs []*int
+	for i := 0; i < 3; i++ {
+		values[0] = i // want `assignment to element of values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+		ptrs = append(ptrs, nullable.IntPtrSlice(values)...)
+	}
+	return ptrs
+}
+
+func loopReassigned(n int) []*int {
+	var ptrs []*int
+	for i := 0; i < n; i++ {
+		values := make([]int, 1)
+		values[0] = i // a new slice in every iteration
+		ptrs = append(ptrs, nullable.IntPtrSlice(values)...)
+	}
+	return ptrs
+}
+
+func loopReassignedAfter(values []int, n int) []*int {
+	var ptrs []*int
+	for i := 0; i < n; i++ {
+		values[0] = i
+		ptrs = append(ptrs, nullable.IntPtrSlice(values)...)
+		values = make([]int, 1)
+	}
+	return ptrs
+}
+
+func ptrIfNonZero(values []string) []*string {
+	ptrs := nullable.PtrIfNonZeroSlice(values)
+	values[0] = "x" // want `assignment to element of values after nullable.PtrIfNonZeroSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func loopDeclared(n int) []*int {
+	var ptrs []*int
+	for i := 0; i < n; i++ {
+		var values = make([]int, 1)
+		values[0] = i
+		ptrs = append(ptrs, nullable.IntPtrSlice(values)...)
+	}
+	return ptrs
+}
@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func intAppend(values []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	values = append(values, 1) // want `append to values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func intAssign(values []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	for i := range values {
+		values[i] = 1 // want `assignment to element of values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func intCopy(values, other []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func intBefore(values []int) []*int {
+	values[0] = 1
+	values = append(values, 1)
+	return nullable.IntPtrSlice(values)
+}
+
+func intOther(values, other []int) []*int {
+	ptrs := nullable.IntPtrSlice(values)
+	other[0] = 1
+	other = append(other, values...)
+	return ptrs
+}
+
+func intClosure(values []int) func() []*int {
+	return func() []*int {
+		ptrs := nullable.IntPtrSlice(values)
+		values[0] = 1 // want `assignment to element of values after nullable.IntPtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
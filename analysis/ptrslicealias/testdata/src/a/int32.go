@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func int32Append(values []int32) []*int32 {
+	ptrs := nullable.Int32PtrSlice(values)
+	values = append(values, 1) // want `append to values after nullable.Int32PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func int32Assign(values []int32) []*int32 {
+	ptrs := nullable.Int32PtrSlice(values)
+	for i := range values {
+		values[i] = 1 // want `assignment to element of values after nullable.Int32PtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func int32Copy(values, other []int32) []*int32 {
+	ptrs := nullable.Int32PtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.Int32PtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.Int32PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func int32Before(values []int32) []*int32 {
+	values[0] = 1
+	values = append(values, 1)
+	return nullable.Int32PtrSlice(values)
+}
+
+func int32Other(values, other []int32) []*int32 {
+	ptrs := nullable.Int32PtrSlice(values)
+	other[0] = 1
+	other = append(other, values...)
+	return ptrs
+}
+
+func int32Closure(values []int32) func() []*int32 {
+	return func() []*int32 {
+		ptrs := nullable.Int32PtrSlice(values)
+		values[0] = 1 // want `assignment to element of values after nullable.Int32PtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
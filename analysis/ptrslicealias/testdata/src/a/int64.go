@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func int64Append(values []int64) []*int64 {
+	ptrs := nullable.Int64PtrSlice(values)
+	values = append(values, 1) // want `append to values after nullable.Int64PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func int64Assign(values []int64) []*int64 {
+	ptrs := nullable.Int64PtrSlice(values)
+	for i := range values {
+		values[i] = 1 // want `assignment to element of values after nullable.Int64PtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func int64Copy(values, other []int64) []*int64 {
+	ptrs := nullable.Int64PtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.Int64PtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.Int64PtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func int64Before(values []int64) []*int64 {
+	values[0] = 1
+	values = append(values, 1)
+	return nullable.Int64PtrSlice(values)
+}
+
+func int64Other(values, other []int64) []*int64 {
+	ptrs := nullable.Int64PtrSlice(values)
+	other[0] = 1
+	other = append(other, values...)
+	return ptrs
+}
+
+func int64Closure(values []int64) func() []*int64 {
+	return func() []*int64 {
+		ptrs := nullable.Int64PtrSlice(values)
+		values[0] = 1 // want `assignment to element of values after nullable.Int64PtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
@@ -0,0 +1,18 @@
+package a
+
+import "github.com/olivere/nullable"
+
+// The PtrSlice methods of Interner and Allocator do not alias src.
+
+func interner(in *nullable.Interner, values []string) []*string {
+	ptrs := in.StringPtrSlice(values)
+	values[0] = "x"
+	return ptrs
+}
+
+func allocator(alloc *nullable.Allocator[int], values []int) []*int {
+	ptrs := alloc.PtrSlice(values)
+	values[0] = 1
+	values = append(values, 1)
+	return ptrs
+}
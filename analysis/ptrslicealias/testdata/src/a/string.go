@@ -0,0 +1,45 @@
+package a
+
+import "github.com/olivere/nullable"
+
+func stringAppend(values []string) []*string {
+	ptrs := nullable.StringPtrSlice(values)
+	values = append(values, "x") // want `append to values after nullable.StringPtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func stringAssign(values []string) []*string {
+	ptrs := nullable.StringPtrSlice(values)
+	for i := range values {
+		values[i] = "x" // want `assignment to element of values after nullable.StringPtrSlice\(values\); the returned pointers alias its elements`
+	}
+	return ptrs
+}
+
+func stringCopy(values, other []string) []*string {
+	ptrs := nullable.StringPtrSlice(values)
+	copy(values, other) // want `copy into values after nullable.StringPtrSlice\(values\); the returned pointers alias its elements`
+	clear(values)       // want `clear of values after nullable.StringPtrSlice\(values\); the returned pointers alias its elements`
+	return ptrs
+}
+
+func stringBefore(values []string) []*string {
+	values[0] = "x"
+	values = append(values, "x")
+	return nullable.StringPtrSlice(values)
+}
+
+func stringOther(values, other []string) []*string {
+	ptrs := nullable.StringPtrSlice(values)
+	other[0] = "x"
+	other = append(other, values...)
+	return ptrs
+}
+
+func stringClosure(values []string) func() []*string {
+	return func() []*string {
+		ptrs := nullable.StringPtrSlice(values)
+		values[0] = "x" // want `assignment to element of values after nullable.StringPtrSlice\(values\); the returned pointers alias its elements`
+		return ptrs
+	}
+}
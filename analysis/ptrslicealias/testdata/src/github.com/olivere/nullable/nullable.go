@@ -0,0 +1,35 @@
+package nullable
+
+func IntPtrSlice(src []int) []*int { return nil }
+
+func Int32PtrSlice(src []int32) []*int32 { return nil }
+
+func Int64PtrSlice(src []int64) []*int64 { return nil }
+
+func Float32PtrSlice(src []float32) []*float32 { return nil }
+
+func Float64PtrSlice(src []float64) []*float64 { return nil }
+
+func StringPtrSlice(src []string) []*string { return nil }
+
+func AppendIntPtrSlice(dst []*int, src []int) []*int { return nil }
+
+func AppendInt32PtrSlice(dst []*int32, src []int32) []*int32 { return nil }
+
+func AppendInt64PtrSlice(dst []*int64, src []int64) []*int64 { return nil }
+
+func AppendFloat32PtrSlice(dst []*float32, src []float32) []*float32 { return nil }
+
+func AppendFloat64PtrSlice(dst []*float64, src []float64) []*float64 { return nil }
+
+func AppendStringPtrSlice(dst []*string, src []string) []*string { return nil }
+
+type Interner struct{}
+
+func (i *Interner) StringPtrSlice(src []string) []*string { return nil }
+
+type Allocator[T any] struct{}
+
+func (a *Allocator[T]) PtrSlice(src []T) []*T { return nil }
+
+func PtrIfNonZeroSlice[T comparable](src []T) []*T { return nil }
@@ -0,0 +1,189 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// The arithmetic functions below propagate nil like SQL propagates NULL:
+// if any of the operands is nil, the result is nil. Otherwise the
+// result is a pointer to a new value.
+//
+// Like the corresponding Go operators, Add, Sub, Mul, Div, Neg and Abs
+// silently wrap around on integer overflow. Use the XChecked variants
+// to detect overflow.
+
+// Add returns a pointer to *a + *b, or nil if a or b is nil.
+func Add[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return x + y })
+}
+
+// Sub returns a pointer to *a - *b, or nil if a or b is nil.
+func Sub[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return x - y })
+}
+
+// Mul returns a pointer to *a * *b, or nil if a or b is nil.
+func Mul[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return x * y })
+}
+
+// Div returns a pointer to *a / *b, or nil if a or b is nil.
+// Like the / operator, it panics if T is an integer type and *b is 0.
+// Use DivOrNil or DivChecked to handle division by zero.
+func Div[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return x / y })
+}
+
+// DivOrNil is like Div but returns nil if *b is 0, like e.g.
+// a / NULLIF(b, 0) in SQL.
+func DivOrNil[T Number](a, b *T) *T {
+	if b != nil && *b == 0 {
+		return nil
+	}
+	return Div(a, b)
+}
+
+// Neg returns a pointer to -*a, or nil if a is nil.
+func Neg[T Number](a *T) *T {
+	return apply1(a, func(x T) T { return -x })
+}
+
+// Abs returns a pointer to the absolute value of *a, or nil if a is nil.
+func Abs[T Number](a *T) *T {
+	return apply1(a, func(x T) T {
+		if x < 0 {
+			return -x
+		}
+		return x
+	})
+}
+
+// Min returns a pointer to the smaller of *a and *b, or nil if a or b is nil.
+func Min[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return min(x, y) })
+}
+
+// Max returns a pointer to the larger of *a and *b, or nil if a or b is nil.
+func Max[T Number](a, b *T) *T {
+	return apply2(a, b, func(x, y T) T { return max(x, y) })
+}
+
+// AddChecked is like Add but returns ErrOverflow if the result
+// overflows T.
+func AddChecked[T Integer](a, b *T) (*T, error) {
+	return apply2Checked(a, b, func(x, y T) (T, error) {
+		z := x + y
+		if isSigned[T]() && ((y > 0 && z < x) || (y < 0 && z > x)) || !isSigned[T]() && z < x {
+			return 0, ErrOverflow
+		}
+		return z, nil
+	})
+}
+
+// SubChecked is like Sub but returns ErrOverflow if the result
+// overflows T.
+func SubChecked[T Integer](a, b *T) (*T, error) {
+	return apply2Checked(a, b, func(x, y T) (T, error) {
+		z := x - y
+		if isSigned[T]() && ((y > 0 && z > x) || (y < 0 && z < x)) || !isSigned[T]() && y > x {
+			return 0, ErrOverflow
+		}
+		return z, nil
+	})
+}
+
+// MulChecked is like Mul but returns ErrOverflow if the result
+// overflows T.
+func MulChecked[T Integer](a, b *T) (*T, error) {
+	return apply2Checked(a, b, func(x, y T) (T, error) {
+		if x == 0 || y == 0 {
+			return 0, nil
+		}
+		if isSigned[T]() {
+			minusOne, minT := ^T(0), minValue[T]()
+			if (x == minusOne && y == minT) || (y == minusOne && x == minT) {
+				return 0, ErrOverflow
+			}
+		}
+		z := x * y
+		if z/y != x {
+			return 0, ErrOverflow
+		}
+		return z, nil
+	})
+}
+
+// DivChecked is like Div but returns ErrDivisionByZero if *b is 0,
+// and ErrOverflow if the result overflows T, e.g. when dividing the
+// smallest int64 by -1.
+func DivChecked[T Integer](a, b *T) (*T, error) {
+	return apply2Checked(a, b, func(x, y T) (T, error) {
+		if y == 0 {
+			return 0, ErrDivisionByZero
+		}
+		if isSigned[T]() && x == minValue[T]() && y == ^T(0) {
+			return 0, ErrOverflow
+		}
+		return x / y, nil
+	})
+}
+
+// NegChecked is like Neg but returns ErrOverflow if the result
+// overflows T, e.g. when negating the smallest int64 or a non-zero
+// unsigned integer.
+func NegChecked[T Integer](a *T) (*T, error) {
+	if a == nil {
+		return nil, nil
+	}
+	if (isSigned[T]() && *a == minValue[T]()) || (!isSigned[T]() && *a != 0) {
+		return nil, ErrOverflow
+	}
+	return Neg(a), nil
+}
+
+// AbsChecked is like Abs but returns ErrOverflow if the result
+// overflows T, i.e. for the smallest value of a signed integer type.
+func AbsChecked[T Integer](a *T) (*T, error) {
+	if a == nil {
+		return nil, nil
+	}
+	if isSigned[T]() && *a == minValue[T]() {
+		return nil, ErrOverflow
+	}
+	return Abs(a), nil
+}
+
+// minValue returns the smallest value of T.
+func minValue[T Integer]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+	return T(1) << (bitSize[T]() - 1)
+}
+
+func apply1[T any](a *T, f func(T) T) *T {
+	if a == nil {
+		return nil
+	}
+	v := f(*a)
+	return &v
+}
+
+func apply2[T any](a, b *T, f func(T, T) T) *T {
+	if a == nil || b == nil {
+		return nil
+	}
+	v := f(*a, *b)
+	return &v
+}
+
+func apply2Checked[T any](a, b *T, f func(T, T) (T, error)) (*T, error) {
+	if a == nil || b == nil {
+		return nil, nil
+	}
+	v, err := f(*a, *b)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
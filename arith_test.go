@@ -0,0 +1,175 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Have   *int
+		Output *int
+	}{
+		{Name: "Add(nil, 2)", Have: Add(nil, IntPtr(2)), Output: nil},
+		{Name: "Add(3, nil)", Have: Add(IntPtr(3), nil), Output: nil},
+		{Name: "Add(3, 2)", Have: Add(IntPtr(3), IntPtr(2)), Output: IntPtr(5)},
+		{Name: "Sub(nil, 2)", Have: Sub(nil, IntPtr(2)), Output: nil},
+		{Name: "Sub(3, 2)", Have: Sub(IntPtr(3), IntPtr(2)), Output: IntPtr(1)},
+		{Name: "Mul(3, nil)", Have: Mul(IntPtr(3), nil), Output: nil},
+		{Name: "Mul(3, 2)", Have: Mul(IntPtr(3), IntPtr(2)), Output: IntPtr(6)},
+		{Name: "Div(nil, 0)", Have: Div(nil, IntPtr(0)), Output: nil},
+		{Name: "Div(7, 2)", Have: Div(IntPtr(7), IntPtr(2)), Output: IntPtr(3)},
+		{Name: "DivOrNil(7, 0)", Have: DivOrNil(IntPtr(7), IntPtr(0)), Output: nil},
+		{Name: "DivOrNil(7, 2)", Have: DivOrNil(IntPtr(7), IntPtr(2)), Output: IntPtr(3)},
+		{Name: "Neg(nil)", Have: Neg[int](nil), Output: nil},
+		{Name: "Neg(3)", Have: Neg(IntPtr(3)), Output: IntPtr(-3)},
+		{Name: "Abs(nil)", Have: Abs[int](nil), Output: nil},
+		{Name: "Abs(-3)", Have: Abs(IntPtr(-3)), Output: IntPtr(3)},
+		{Name: "Abs(3)", Have: Abs(IntPtr(3)), Output: IntPtr(3)},
+		{Name: "Min(nil, 2)", Have: Min(nil, IntPtr(2)), Output: nil},
+		{Name: "Min(3, 2)", Have: Min(IntPtr(3), IntPtr(2)), Output: IntPtr(2)},
+		{Name: "Max(3, nil)", Have: Max(IntPtr(3), nil), Output: nil},
+		{Name: "Max(3, 2)", Have: Max(IntPtr(3), IntPtr(2)), Output: IntPtr(3)},
+	}
+
+	for i, tt := range tests {
+		if have, want := tt.Have, tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have %s = %v, want %v", i, tt.Name, have, want)
+		}
+	}
+}
+
+func TestArithmeticFloat(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Have   *float64
+		Output *float64
+	}{
+		{Name: "Add(1.5, 2.25)", Have: Add(Float64Ptr(1.5), Float64Ptr(2.25)), Output: Float64Ptr(3.75)},
+		{Name: "Div(1, 4)", Have: Div(Float64Ptr(1), Float64Ptr(4)), Output: Float64Ptr(0.25)},
+		{Name: "Div(1, 0)", Have: Div(Float64Ptr(1), Float64Ptr(0)), Output: Float64Ptr(math.Inf(+1))},
+		{Name: "DivOrNil(1, 0)", Have: DivOrNil(Float64Ptr(1), Float64Ptr(0)), Output: nil},
+		{Name: "Abs(-1.5)", Have: Abs(Float64Ptr(-1.5)), Output: Float64Ptr(1.5)},
+	}
+
+	for i, tt := range tests {
+		if have, want := tt.Have, tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have %s = %v, want %v", i, tt.Name, have, want)
+		}
+	}
+}
+
+func TestArithmeticDuration(t *testing.T) {
+	have := Add(DurationPtr(time.Second), DurationPtr(time.Minute))
+	if want := DurationPtr(61 * time.Second); !Equal(have, want) {
+		t.Errorf("have Add(1s, 1m) = %v, want %v", have, want)
+	}
+}
+
+func TestArithmeticDoesNotModifyOperands(t *testing.T) {
+	a, b := IntPtr(3), IntPtr(2)
+	if have := Add(a, b); have == a || have == b {
+		t.Errorf("expected Add to return a new pointer")
+	}
+	if *a != 3 || *b != 2 {
+		t.Errorf("expected Add to not modify its operands, have %d and %d", *a, *b)
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	tests := []struct {
+		Name   string
+		F      func() (*int8, error)
+		Output *int8
+		Error  error
+	}{
+		{Name: "AddChecked(nil, 1)", F: func() (*int8, error) { return AddChecked(nil, int8Ptr(1)) }},
+		{Name: "AddChecked(100, 27)", F: func() (*int8, error) { return AddChecked(int8Ptr(100), int8Ptr(27)) }, Output: int8Ptr(127)},
+		{Name: "AddChecked(100, 28)", F: func() (*int8, error) { return AddChecked(int8Ptr(100), int8Ptr(28)) }, Error: ErrOverflow},
+		{Name: "AddChecked(-100, -29)", F: func() (*int8, error) { return AddChecked(int8Ptr(-100), int8Ptr(-29)) }, Error: ErrOverflow},
+		{Name: "SubChecked(-100, 28)", F: func() (*int8, error) { return SubChecked(int8Ptr(-100), int8Ptr(28)) }, Output: int8Ptr(-128)},
+		{Name: "SubChecked(-100, 29)", F: func() (*int8, error) { return SubChecked(int8Ptr(-100), int8Ptr(29)) }, Error: ErrOverflow},
+		{Name: "SubChecked(100, -28)", F: func() (*int8, error) { return SubChecked(int8Ptr(100), int8Ptr(-28)) }, Error: ErrOverflow},
+		{Name: "MulChecked(0, -128)", F: func() (*int8, error) { return MulChecked(int8Ptr(0), int8Ptr(-128)) }, Output: int8Ptr(0)},
+		{Name: "MulChecked(-8, 16)", F: func() (*int8, error) { return MulChecked(int8Ptr(-8), int8Ptr(16)) }, Output: int8Ptr(-128)},
+		{Name: "MulChecked(8, 16)", F: func() (*int8, error) { return MulChecked(int8Ptr(8), int8Ptr(16)) }, Error: ErrOverflow},
+		{Name: "MulChecked(-1, -128)", F: func() (*int8, error) { return MulChecked(int8Ptr(-1), int8Ptr(-128)) }, Error: ErrOverflow},
+		{Name: "MulChecked(-128, -1)", F: func() (*int8, error) { return MulChecked(int8Ptr(-128), int8Ptr(-1)) }, Error: ErrOverflow},
+		{Name: "DivChecked(7, 2)", F: func() (*int8, error) { return DivChecked(int8Ptr(7), int8Ptr(2)) }, Output: int8Ptr(3)},
+		{Name: "DivChecked(7, 0)", F: func() (*int8, error) { return DivChecked(int8Ptr(7), int8Ptr(0)) }, Error: ErrDivisionByZero},
+		{Name: "DivChecked(-128, -1)", F: func() (*int8, error) { return DivChecked(int8Ptr(-128), int8Ptr(-1)) }, Error: ErrOverflow},
+		{Name: "NegChecked(nil)", F: func() (*int8, error) { return NegChecked[int8](nil) }},
+		{Name: "NegChecked(127)", F: func() (*int8, error) { return NegChecked(int8Ptr(127)) }, Output: int8Ptr(-127)},
+		{Name: "NegChecked(-128)", F: func() (*int8, error) { return NegChecked(int8Ptr(-128)) }, Error: ErrOverflow},
+		{Name: "AbsChecked(-127)", F: func() (*int8, error) { return AbsChecked(int8Ptr(-127)) }, Output: int8Ptr(127)},
+		{Name: "AbsChecked(-128)", F: func() (*int8, error) { return AbsChecked(int8Ptr(-128)) }, Error: ErrOverflow},
+	}
+
+	for i, tt := range tests {
+		have, err := tt.F()
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have %s error = %v, want %v", i, tt.Name, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have %s = %v, want %v", i, tt.Name, have, want)
+		}
+	}
+}
+
+func TestCheckedArithmeticUnsigned(t *testing.T) {
+	u := func(v uint8) *uint8 { return &v }
+	tests := []struct {
+		Name   string
+		F      func() (*uint8, error)
+		Output *uint8
+		Error  error
+	}{
+		{Name: "AddChecked(200, 55)", F: func() (*uint8, error) { return AddChecked(u(200), u(55)) }, Output: u(255)},
+		{Name: "AddChecked(200, 56)", F: func() (*uint8, error) { return AddChecked(u(200), u(56)) }, Error: ErrOverflow},
+		{Name: "SubChecked(1, 1)", F: func() (*uint8, error) { return SubChecked(u(1), u(1)) }, Output: u(0)},
+		{Name: "SubChecked(1, 2)", F: func() (*uint8, error) { return SubChecked(u(1), u(2)) }, Error: ErrOverflow},
+		{Name: "MulChecked(15, 17)", F: func() (*uint8, error) { return MulChecked(u(15), u(17)) }, Output: u(255)},
+		{Name: "MulChecked(16, 16)", F: func() (*uint8, error) { return MulChecked(u(16), u(16)) }, Error: ErrOverflow},
+		{Name: "DivChecked(255, 255)", F: func() (*uint8, error) { return DivChecked(u(255), u(255)) }, Output: u(1)},
+		{Name: "NegChecked(0)", F: func() (*uint8, error) { return NegChecked(u(0)) }, Output: u(0)},
+		{Name: "NegChecked(1)", F: func() (*uint8, error) { return NegChecked(u(1)) }, Error: ErrOverflow},
+		{Name: "AbsChecked(255)", F: func() (*uint8, error) { return AbsChecked(u(255)) }, Output: u(255)},
+	}
+
+	for i, tt := range tests {
+		have, err := tt.F()
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have %s error = %v, want %v", i, tt.Name, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have %s = %v, want %v", i, tt.Name, have, want)
+		}
+	}
+}
+
+func TestCheckedArithmeticInt64(t *testing.T) {
+	if _, err := AddChecked(Int64Ptr(math.MaxInt64), Int64Ptr(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("have AddChecked(MaxInt64, 1) error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := MulChecked(Int64Ptr(math.MaxInt64/2+1), Int64Ptr(2)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("have MulChecked(MaxInt64/2+1, 2) error = %v, want %v", err, ErrOverflow)
+	}
+	have, err := MulChecked(Int64Ptr(math.MinInt64/2), Int64Ptr(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Int64Ptr(math.MinInt64); !Equal(have, want) {
+		t.Errorf("have MulChecked(MinInt64/2, 2) = %v, want %v", have, want)
+	}
+}
+
+func int8Ptr(v int8) *int8 {
+	return &v
+}
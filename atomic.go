@@ -0,0 +1,79 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"sync/atomic"
+)
+
+// Atomic is an optional value that can be read and written
+// by multiple goroutines concurrently. The zero value is unset.
+//
+// Atomic never hands out pointers to the value it holds,
+// so callers cannot modify it other than via its methods.
+type Atomic[T comparable] struct {
+	p atomic.Pointer[T]
+}
+
+// Load returns the value and true if it is set.
+// Otherwise it returns an empty value and false.
+func (a *Atomic[T]) Load() (T, bool) {
+	if p := a.p.Load(); p != nil {
+		return *p, true
+	}
+	var v T
+	return v, false
+}
+
+// LoadOr returns the value if it is set. Otherwise it returns d.
+func (a *Atomic[T]) LoadOr(d T) T {
+	if p := a.p.Load(); p != nil {
+		return *p
+	}
+	return d
+}
+
+// Store sets the value to v.
+func (a *Atomic[T]) Store(v T) {
+	a.p.Store(&v)
+}
+
+// Clear unsets the value.
+func (a *Atomic[T]) Clear() {
+	a.p.Store(nil)
+}
+
+// Swap sets the value to *v, or unsets it if v is nil.
+// It returns a pointer to a copy of the previous value,
+// or nil if it was unset.
+func (a *Atomic[T]) Swap(v *T) *T {
+	return clone(a.p.Swap(clone(v)))
+}
+
+// CompareAndSwap sets the value to *new, or unsets it if new is nil,
+// if the current value equals *old, or is unset and old is nil.
+// It reports whether the value has been swapped.
+func (a *Atomic[T]) CompareAndSwap(old, new *T) bool {
+	n := clone(new)
+	for {
+		cur := a.p.Load()
+		if !Equal(cur, old) {
+			return false
+		}
+		if a.p.CompareAndSwap(cur, n) {
+			return true
+		}
+		// Modified concurrently since we loaded cur; try again
+	}
+}
+
+// clone returns a pointer to a copy of *v, or nil if v is nil.
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
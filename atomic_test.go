@@ -0,0 +1,180 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAtomic(t *testing.T) {
+	var a Atomic[time.Duration]
+
+	if v, ok := a.Load(); ok || v != 0 {
+		t.Errorf("have Load() = (%v, %v), want (%v, %v)", v, ok, time.Duration(0), false)
+	}
+	if have, want := a.LoadOr(time.Second), time.Second; have != want {
+		t.Errorf("have LoadOr(%v) = %v, want %v", time.Second, have, want)
+	}
+
+	a.Store(5 * time.Second)
+	if v, ok := a.Load(); !ok || v != 5*time.Second {
+		t.Errorf("have Load() = (%v, %v), want (%v, %v)", v, ok, 5*time.Second, true)
+	}
+	if have, want := a.LoadOr(time.Second), 5*time.Second; have != want {
+		t.Errorf("have LoadOr(%v) = %v, want %v", time.Second, have, want)
+	}
+
+	a.Clear()
+	if v, ok := a.Load(); ok || v != 0 {
+		t.Errorf("have Load() after Clear = (%v, %v), want (%v, %v)", v, ok, time.Duration(0), false)
+	}
+}
+
+func TestAtomicSwap(t *testing.T) {
+	tests := []struct {
+		Init *int
+		New  *int
+		Old  *int
+	}{
+		{nil, nil, nil},
+		{nil, IntPtr(1), nil},
+		{IntPtr(1), IntPtr(2), IntPtr(1)},
+		{IntPtr(1), nil, IntPtr(1)},
+	}
+
+	for i, tt := range tests {
+		var a Atomic[int]
+		a.Swap(tt.Init)
+		if have := a.Swap(tt.New); !Equal(have, tt.Old) {
+			t.Errorf("#%d: have Swap(%v) = %v, want %v", i, tt.New, have, tt.Old)
+		}
+		if v, ok := a.Load(); ok != (tt.New != nil) || (ok && v != *tt.New) {
+			t.Errorf("#%d: have Load() = (%v, %v), want %v", i, v, ok, tt.New)
+		}
+	}
+}
+
+func TestAtomicSwapCopies(t *testing.T) {
+	var a Atomic[int]
+	v := IntPtr(1)
+	a.Swap(v)
+	*v = 2
+	if have, want := a.LoadOr(0), 1; have != want {
+		t.Errorf("have LoadOr(0) = %v, want %v", have, want)
+	}
+}
+
+func TestAtomicSwapReturnsCopy(t *testing.T) {
+	var a Atomic[int]
+	a.Store(1)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10000; i++ {
+				_, _ = a.Load()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10000; i++ {
+			// Swap in the same value, so concurrent Loads may still read
+			// the value that has been swapped out; writing through the
+			// returned pointer must not affect them.
+			if old := a.Swap(IntPtr(1)); old != nil {
+				*old = 2
+			}
+		}
+	}()
+	wg.Wait()
+
+	if have, want := a.LoadOr(0), 1; have != want {
+		t.Errorf("have LoadOr(0) = %v, want %v", have, want)
+	}
+}
+
+func TestAtomicCompareAndSwap(t *testing.T) {
+	tests := []struct {
+		Init    *int
+		Old     *int
+		New     *int
+		Swapped bool
+		Output  *int
+	}{
+		{nil, nil, IntPtr(1), true, IntPtr(1)},
+		{nil, IntPtr(0), IntPtr(1), false, nil},
+		{IntPtr(0), nil, IntPtr(1), false, IntPtr(0)},
+		{IntPtr(1), IntPtr(1), IntPtr(2), true, IntPtr(2)},
+		{IntPtr(1), IntPtr(2), IntPtr(3), false, IntPtr(1)},
+		{IntPtr(1), IntPtr(1), nil, true, nil},
+	}
+
+	for i, tt := range tests {
+		var a Atomic[int]
+		a.Swap(tt.Init)
+		if have := a.CompareAndSwap(tt.Old, tt.New); have != tt.Swapped {
+			t.Errorf("#%d: have CompareAndSwap(%v, %v) = %v, want %v", i, tt.Old, tt.New, have, tt.Swapped)
+		}
+		if have := a.Swap(nil); !Equal(have, tt.Output) {
+			t.Errorf("#%d: have value %v, want %v", i, have, tt.Output)
+		}
+	}
+}
+
+func TestAtomicConcurrent(t *testing.T) {
+	var a Atomic[int]
+	const goroutines = 8
+	const increments = 1000
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < increments; i++ {
+				for {
+					v, ok := a.Load()
+					old := &v
+					if !ok {
+						old = nil
+					}
+					if a.CompareAndSwap(old, IntPtr(v+1)) {
+						break
+					}
+				}
+				_ = a.LoadOr(-1)
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < increments; i++ {
+			_, _ = a.Load()
+		}
+	}()
+	wg.Wait()
+
+	if have, want := a.LoadOr(0), goroutines*increments; have != want {
+		t.Errorf("have LoadOr(0) = %v, want %v", have, want)
+	}
+}
+
+func BenchmarkAtomicLoadOr(b *testing.B) {
+	var a Atomic[time.Duration]
+	a.Store(time.Second)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = a.LoadOr(0)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+module github.com/olivere/nullable/cmd
+
+go 1.25.0
+
+require (
+	github.com/olivere/nullable/analysis v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.47.0
+)
+
+require (
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+)
+
+// Use the analyzers of this repository for local development.
+replace github.com/olivere/nullable/analysis => ../analysis
@@ -0,0 +1,194 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines around a change.
+const diffContext = 3
+
+// unifiedDiff returns the changes from a to b in unified diff format,
+// or "" if a and b are equal. It computes a shortest edit script in
+// O((n+m) * d) time and O(n+m) space for n and m lines and d changes.
+func unifiedDiff(filename string, a, b []byte) string {
+	x := splitLines(string(a))
+	y := splitLines(string(b))
+	ops := diffLines(x, y)
+
+	var sb strings.Builder
+	for i := 0; i < len(ops); {
+		// Skip to the next change.
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// Extend the hunk as long as changes are close to each other.
+		start := max(i-diffContext, 0)
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != ' ' {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		end = min(end+diffContext, len(ops))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", filename, filename)
+		}
+		var lines strings.Builder
+		ax, ay := ops[start].x, ops[start].y
+		nx, ny := 0, 0
+		for _, op := range ops[start:end] {
+			lines.WriteByte(op.kind)
+			lines.WriteString(op.line)
+			if !strings.HasSuffix(op.line, "\n") {
+				lines.WriteString("\n\\ No newline at end of file\n")
+			}
+			if op.kind != '+' {
+				nx++
+			}
+			if op.kind != '-' {
+				ny++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(ax, nx), hunkRange(ay, ny))
+		sb.WriteString(lines.String())
+		i = end
+	}
+	return sb.String()
+}
+
+func hunkRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+type diffOp struct {
+	kind byte   // ' ', '-' or '+'
+	line string // line including its newline
+	x, y int    // index of the line in a and b before this op
+}
+
+// diffLines returns the edit script transforming x into y.
+func diffLines(x, y []string) []diffOp {
+	ops := make([]diffOp, 0, max(len(x), len(y)))
+	return diffRange(ops, x, y, 0, 0)
+}
+
+// diffRange appends the edit script transforming x into y to ops,
+// with x and y starting at line x0 and y0 of the original input.
+// It uses the linear space variant of the algorithm in Eugene W. Myers,
+// "An O(ND) Difference Algorithm and Its Variations" (1986).
+func diffRange(ops []diffOp, x, y []string, x0, y0 int) []diffOp {
+	// Trim the common prefix and suffix.
+	pre := 0
+	for pre < len(x) && pre < len(y) && x[pre] == y[pre] {
+		ops = append(ops, diffOp{' ', x[pre], x0 + pre, y0 + pre})
+		pre++
+	}
+	x, y = x[pre:], y[pre:]
+	x0, y0 = x0+pre, y0+pre
+	suf := 0
+	for suf < len(x) && suf < len(y) && x[len(x)-1-suf] == y[len(y)-1-suf] {
+		suf++
+	}
+	n, m := len(x)-suf, len(y)-suf
+
+	switch {
+	case n == 0:
+		for j := 0; j < m; j++ {
+			ops = append(ops, diffOp{'+', y[j], x0, y0 + j})
+		}
+	case m == 0:
+		for i := 0; i < n; i++ {
+			ops = append(ops, diffOp{'-', x[i], x0 + i, y0})
+		}
+	default:
+		xs, ys, xe, ye := middleSnake(x[:n], y[:m])
+		ops = diffRange(ops, x[:xs], y[:ys], x0, y0)
+		for i := xs; i < xe; i++ {
+			ops = append(ops, diffOp{' ', x[i], x0 + i, y0 + ys + i - xs})
+		}
+		ops = diffRange(ops, x[xe:n], y[ye:m], x0+xe, y0+ye)
+	}
+
+	for k := 0; k < suf; k++ {
+		ops = append(ops, diffOp{' ', x[n+k], x0 + n + k, y0 + m + k})
+	}
+	return ops
+}
+
+// middleSnake returns the start (xs, ys) and end (xe, ye) of the middle
+// snake of a shortest edit script transforming x into y, i.e. of the
+// diagonal in the middle of the edit graph. x and y must not be empty,
+// and must not have a common prefix or suffix.
+func middleSnake(x, y []string) (xs, ys, xe, ye int) {
+	n, m := len(x), len(y)
+	dmax := (n + m + 1) / 2
+	off := dmax + 1
+	// vf[off+k] is the furthest x reached on diagonal k = x-y going
+	// forward, vb[off+c] the furthest x reached on diagonal c = x-y
+	// of the reversed inputs going backward.
+	vf := make([]int, 2*dmax+3)
+	vb := make([]int, 2*dmax+3)
+	delta := n - m
+	odd := delta%2 != 0
+
+	for d := 0; d <= dmax; d++ {
+		for k := -d; k <= d; k += 2 {
+			var i int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				i = vf[off+k+1]
+			} else {
+				i = vf[off+k-1] + 1
+			}
+			j := i - k
+			i0, j0 := i, j
+			for i < n && j < m && x[i] == y[j] {
+				i++
+				j++
+			}
+			vf[off+k] = i
+			if c := delta - k; odd && c >= -(d-1) && c <= d-1 && i+vb[off+c] >= n {
+				return i0, j0, i, j
+			}
+		}
+		for c := -d; c <= d; c += 2 {
+			var i int
+			if c == -d || (c != d && vb[off+c-1] < vb[off+c+1]) {
+				i = vb[off+c+1]
+			} else {
+				i = vb[off+c-1] + 1
+			}
+			j := i - c
+			i0, j0 := i, j
+			for i < n && j < m && x[n-1-i] == y[m-1-j] {
+				i++
+				j++
+			}
+			vb[off+c] = i
+			if k := delta - c; !odd && k >= -d && k <= d && i+vf[off+k] >= n {
+				return n - i, m - j, n - i0, m - j0
+			}
+		}
+	}
+	panic("unreachable")
+}
+
+// splitLines splits s into lines, keeping the line endings.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+// Nullablefix rewrites Go source files to use package nullable instead
+// of the pointer helpers of the AWS SDK for Go (aws.String, aws.StringValue,
+// aws.Int64Value etc.) and Kubernetes (pointer.StringPtr,
+// pointer.StringPtrDerefOr etc.).
+//
+// Usage:
+//
+//	nullablefix [flags] [path ...]
+//
+// Without flags, the rewritten sources are printed to standard output.
+// Directories are processed recursively, skipping vendor, testdata and
+// hidden directories, and files not ending in .go. Files given as
+// arguments are always processed. Without paths, nullablefix
+// processes standard input.
+//
+// The flags are:
+//
+//	-d
+//		Do not print the rewritten sources, but a diff of the changes.
+//	-l
+//		Do not print the rewritten sources, but the names of files
+//		that would be changed.
+//	-w
+//		Do not print the rewritten sources, but write them back to
+//		the source files.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	doDiff  = flag.Bool("d", false, "display diffs instead of rewriting files")
+	list    = flag.Bool("l", false, "list files that would be rewritten")
+	doWrite = flag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: nullablefix [flags] [path ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		if *doWrite {
+			fmt.Fprintln(os.Stderr, "nullablefix: cannot use -w with standard input")
+			os.Exit(2)
+		}
+		if err := processFile("<standard input>", os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	exitCode := 0
+	for _, root := range flag.Args() {
+		if err := processPath(root, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 1
+		}
+	}
+	os.Exit(exitCode)
+}
+
+// processPath rewrites root if it is a file, or all Go files
+// in root and its subdirectories if it is a directory.
+func processPath(root string, out io.Writer) error {
+	fi, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		// Process files given explicitly, like gofmt does
+		return processFile(root, nil, out)
+	}
+	var errs []error
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		if err := processFile(path, nil, out); err != nil {
+			errs = append(errs, err)
+		}
+		return nil
+	})
+	return errors.Join(append(errs, err)...)
+}
+
+// processFile rewrites filename, reading it from in if in is not nil.
+func processFile(filename string, in io.Reader, out io.Writer) error {
+	var src []byte
+	var err error
+	if in != nil {
+		src, err = io.ReadAll(in)
+	} else {
+		src, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return err
+	}
+
+	res, changed, err := rewrite(filename, src)
+	if err != nil {
+		return err
+	}
+
+	if !*list && !*doWrite && !*doDiff {
+		_, err = out.Write(res)
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	if *list {
+		fmt.Fprintln(out, filename)
+	}
+	if *doWrite {
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, res, fi.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if *doDiff {
+		fmt.Fprint(out, unifiedDiff(filepath.ToSlash(filename), src, res))
+	}
+	return nil
+}
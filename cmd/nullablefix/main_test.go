@@ -0,0 +1,75 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProcessPath(t *testing.T) {
+	const src = `package p
+
+import "github.com/aws/aws-sdk-go/aws"
+
+var s = aws.String("x")
+`
+	dir := t.TempDir()
+	for _, name := range []string{
+		"p/a.go",
+		"p/.hidden/b.go",
+		"p/testdata/c.go",
+		"p/vendor/d.go",
+		"p/e.go.txt",
+	} {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defer func(v bool) { *list = v }(*list)
+	*list = true
+
+	tests := []struct {
+		Root   string
+		Output []string
+	}{
+		{Root: "p", Output: []string{"p/a.go"}},
+		{Root: "p/.hidden", Output: []string{"p/.hidden/b.go"}},
+		{Root: "p/testdata", Output: []string{"p/testdata/c.go"}},
+		{Root: "p/.hidden/..", Output: []string{"p/a.go"}},
+		{Root: "p/e.go.txt", Output: []string{"p/e.go.txt"}},
+	}
+
+	for i, tt := range tests {
+		root := dir + "/" + tt.Root
+		var out strings.Builder
+		if err := processPath(root, &out); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		var want []string
+		for _, name := range tt.Output {
+			want = append(want, filepath.Join(dir, filepath.FromSlash(name)))
+		}
+		// Walking p/.hidden/.. lists p/.hidden/../a.go
+		have := strings.Fields(out.String())
+		for j := range have {
+			have[j] = filepath.Clean(have[j])
+		}
+		if strings.Join(have, " ") != strings.Join(want, " ") {
+			t.Errorf("#%d: have processPath(%q) listing %v, want %v", i, tt.Root, have, want)
+		}
+	}
+
+	if err := processPath(filepath.Join(dir, "missing"), new(strings.Builder)); err == nil {
+		t.Error("expected processPath of a missing path to fail")
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const nullableImportPath = "github.com/olivere/nullable"
+
+// rules maps import paths to the functions of that package
+// and their equivalents in package nullable.
+//
+// Functions like aws.StringSlice are not rewritten: they copy each
+// element, while e.g. nullable.StringPtrSlice returns pointers into
+// the source slice.
+var rules = map[string]map[string]string{
+	// https://github.com/aws/aws-sdk-go/blob/master/aws/convert_types.go
+	"github.com/aws/aws-sdk-go/aws": {
+		"String":            "StringPtr",
+		"StringValue":       "String",
+		"StringValueSlice":  "StringSlice",
+		"Bool":              "BoolPtr",
+		"BoolValue":         "Bool",
+		"Int":               "IntPtr",
+		"IntValue":          "Int",
+		"IntValueSlice":     "IntSlice",
+		"Int32":             "Int32Ptr",
+		"Int32Value":        "Int32",
+		"Int32ValueSlice":   "Int32Slice",
+		"Int64":             "Int64Ptr",
+		"Int64Value":        "Int64",
+		"Int64ValueSlice":   "Int64Slice",
+		"Float32":           "Float32Ptr",
+		"Float32Value":      "Float32",
+		"Float32ValueSlice": "Float32Slice",
+		"Float64":           "Float64Ptr",
+		"Float64Value":      "Float64",
+		"Float64ValueSlice": "Float64Slice",
+		"Time":              "TimePtr",
+		"TimeValue":         "Time",
+	},
+	// https://github.com/aws/aws-sdk-go-v2/blob/main/aws/to_ptr.go
+	// https://github.com/aws/aws-sdk-go-v2/blob/main/aws/from_ptr.go
+	"github.com/aws/aws-sdk-go-v2/aws": {
+		"String":         "StringPtr",
+		"ToString":       "String",
+		"ToStringSlice":  "StringSlice",
+		"Bool":           "BoolPtr",
+		"ToBool":         "Bool",
+		"Int":            "IntPtr",
+		"ToInt":          "Int",
+		"ToIntSlice":     "IntSlice",
+		"Int32":          "Int32Ptr",
+		"ToInt32":        "Int32",
+		"ToInt32Slice":   "Int32Slice",
+		"Int64":          "Int64Ptr",
+		"ToInt64":        "Int64",
+		"ToInt64Slice":   "Int64Slice",
+		"Float32":        "Float32Ptr",
+		"ToFloat32":      "Float32",
+		"ToFloat32Slice": "Float32Slice",
+		"Float64":        "Float64Ptr",
+		"ToFloat64":      "Float64",
+		"ToFloat64Slice": "Float64Slice",
+		"Time":           "TimePtr",
+		"ToTime":         "Time",
+		"Duration":       "DurationPtr",
+		"ToDuration":     "Duration",
+	},
+	// https://github.com/kubernetes/utils/blob/master/pointer/pointer.go
+	"k8s.io/utils/pointer": {
+		"Int":               "IntPtr",
+		"IntPtr":            "IntPtr",
+		"IntDeref":          "IntWithDefault",
+		"IntPtrDerefOr":     "IntWithDefault",
+		"Int32":             "Int32Ptr",
+		"Int32Ptr":          "Int32Ptr",
+		"Int32Deref":        "Int32WithDefault",
+		"Int32PtrDerefOr":   "Int32WithDefault",
+		"Int64":             "Int64Ptr",
+		"Int64Ptr":          "Int64Ptr",
+		"Int64Deref":        "Int64WithDefault",
+		"Int64PtrDerefOr":   "Int64WithDefault",
+		"Bool":              "BoolPtr",
+		"BoolPtr":           "BoolPtr",
+		"BoolDeref":         "BoolWithDefault",
+		"BoolPtrDerefOr":    "BoolWithDefault",
+		"String":            "StringPtr",
+		"StringPtr":         "StringPtr",
+		"StringDeref":       "StringWithDefault",
+		"StringPtrDerefOr":  "StringWithDefault",
+		"Float32":           "Float32Ptr",
+		"Float32Ptr":        "Float32Ptr",
+		"Float32Deref":      "Float32WithDefault",
+		"Float32PtrDerefOr": "Float32WithDefault",
+		"Float64":           "Float64Ptr",
+		"Float64Ptr":        "Float64Ptr",
+		"Float64Deref":      "Float64WithDefault",
+		"Float64PtrDerefOr": "Float64WithDefault",
+		"Duration":          "DurationPtr",
+		"DurationDeref":     "DurationWithDefault",
+	},
+}
+
+// rewrite rewrites the references to functions in rules in src
+// to the equivalent functions in package nullable. It returns
+// the formatted result and whether anything has been changed.
+func rewrite(filename string, src []byte) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+	if !rewriteFile(fset, file) {
+		return src, false, nil
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), true, nil
+}
+
+// rewriteFile rewrites file in place and returns true if it changed it.
+func rewriteFile(fset *token.FileSet, file *ast.File) bool {
+	// Find the local names of the packages we know how to rewrite.
+	names := make(map[string]map[string]string)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		funcs, ok := rules[path]
+		if !ok {
+			continue
+		}
+		name := pathToName(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == "_" || name == "." {
+			continue
+		}
+		names[name] = funcs
+	}
+	if len(names) == 0 {
+		return false
+	}
+
+	nullableName, imported := "nullable", false
+	for _, spec := range file.Imports {
+		if spec.Path.Value != strconv.Quote(nullableImportPath) {
+			continue
+		}
+		if spec.Name == nil {
+			nullableName, imported = "nullable", true
+			break
+		}
+		if name := spec.Name.Name; name != "_" && name != "." {
+			nullableName, imported = name, true
+			break
+		}
+	}
+
+	changed := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		id, ok := sel.X.(*ast.Ident)
+		if !ok || id.Obj != nil {
+			// id.Obj != nil means it refers to a local declaration,
+			// e.g. a variable that shadows the package name.
+			return true
+		}
+		funcs, ok := names[id.Name]
+		if !ok {
+			return true
+		}
+		if fn, ok := funcs[sel.Sel.Name]; ok {
+			id.Name = nullableName
+			sel.Sel.Name = fn
+			changed = true
+		}
+		return true
+	})
+	if !changed {
+		return false
+	}
+
+	if !imported {
+		astutil.AddImport(fset, file, nullableImportPath)
+	}
+	specs := make(map[*ast.GenDecl]int)
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			specs[gen] = len(gen.Specs)
+		}
+	}
+	for path := range rules {
+		if !astutil.UsesImport(file, path) {
+			for _, spec := range file.Imports {
+				if spec.Path.Value != strconv.Quote(path) {
+					continue
+				}
+				if spec.Name != nil {
+					astutil.DeleteNamedImport(fset, file, spec.Name.Name, path)
+				} else {
+					astutil.DeleteImport(fset, file, path)
+				}
+				break
+			}
+		}
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT || len(gen.Specs) != 1 || len(gen.Specs) == specs[gen] {
+			continue
+		}
+		// Remove the parens of an import declaration that has only
+		// one import left, e.g. after replacing a single import.
+		if spec := gen.Specs[0].(*ast.ImportSpec); spec.Doc == nil && spec.Comment == nil {
+			gen.Lparen, gen.Rparen = token.NoPos, token.NoPos
+		}
+	}
+	return true
+}
+
+// pathToName returns the default package name for an import path.
+func pathToName(path string) string {
+	switch path {
+	case "github.com/aws/aws-sdk-go/aws", "github.com/aws/aws-sdk-go-v2/aws":
+		return "aws"
+	case "k8s.io/utils/pointer":
+		return "pointer"
+	}
+	return ""
+}
@@ -0,0 +1,331 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package main
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestRewrite(t *testing.T) {
+	tests := []struct {
+		Input   string
+		Output  string
+		Changed bool
+	}{
+		// #0: AWS SDK for Go
+		{
+			Input: `package p
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func f(name *string, size *int64, tags []*string) {
+	// Print the name.
+	fmt.Println(aws.StringValue(name), aws.Int64Value(size))
+	_ = aws.String("x")
+	_ = aws.StringValueSlice(tags)
+}
+`,
+			Output: `package p
+
+import (
+	"fmt"
+
+	"github.com/olivere/nullable"
+)
+
+func f(name *string, size *int64, tags []*string) {
+	// Print the name.
+	fmt.Println(nullable.String(name), nullable.Int64(size))
+	_ = nullable.StringPtr("x")
+	_ = nullable.StringSlice(tags)
+}
+`,
+			Changed: true,
+		},
+		// #1: Keep the aws import if it is still used
+		{
+			Input: `package p
+
+import "github.com/aws/aws-sdk-go/aws"
+
+var cfg = aws.Config{Region: aws.String("eu-central-1")}
+`,
+			Output: `package p
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/olivere/nullable"
+)
+
+var cfg = aws.Config{Region: nullable.StringPtr("eu-central-1")}
+`,
+			Changed: true,
+		},
+		// #2: Kubernetes pointer package, named import
+		{
+			Input: `package p
+
+import ptr "k8s.io/utils/pointer"
+
+func f(replicas *int32) int32 {
+	_ = ptr.StringPtr("x")
+	return ptr.Int32PtrDerefOr(replicas, 1)
+}
+`,
+			Output: `package p
+
+import "github.com/olivere/nullable"
+
+func f(replicas *int32) int32 {
+	_ = nullable.StringPtr("x")
+	return nullable.Int32WithDefault(replicas, 1)
+}
+`,
+			Changed: true,
+		},
+		// #3: Use the existing import of package nullable
+		{
+			Input: `package p
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	nl "github.com/olivere/nullable"
+)
+
+func f(name *string, d *int) (string, int) {
+	return aws.ToString(name), nl.IntWithDefault(d, 1)
+}
+`,
+			Output: `package p
+
+import nl "github.com/olivere/nullable"
+
+func f(name *string, d *int) (string, int) {
+	return nl.String(name), nl.IntWithDefault(d, 1)
+}
+`,
+			Changed: true,
+		},
+		// #4: Do not rewrite functions that copy slice elements,
+		// as nullable.XPtrSlice returns pointers into the slice
+		{
+			Input: `package p
+
+import "github.com/aws/aws-sdk-go-v2/aws"
+
+func f(tags []string, name *string) ([]*string, string) {
+	ptrs := aws.StringSlice(tags)
+	tags[0] = "changed"
+	return ptrs, aws.ToString(name)
+}
+`,
+			Output: `package p
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/olivere/nullable"
+)
+
+func f(tags []string, name *string) ([]*string, string) {
+	ptrs := aws.StringSlice(tags)
+	tags[0] = "changed"
+	return ptrs, nullable.String(name)
+}
+`,
+			Changed: true,
+		},
+		// #5: Add a usable import if package nullable is only imported
+		// for its side effects
+		{
+			Input: `package p
+
+import (
+	_ "github.com/olivere/nullable"
+	"k8s.io/utils/pointer"
+)
+
+var s = pointer.String("x")
+`,
+			Output: `package p
+
+import (
+	"github.com/olivere/nullable"
+	_ "github.com/olivere/nullable"
+)
+
+var s = nullable.StringPtr("x")
+`,
+			Changed: true,
+		},
+		// #6: Do not rewrite local variables shadowing the package
+		{
+			Input: `package p
+
+import "github.com/aws/aws-sdk-go/aws"
+
+type T struct{}
+
+func (T) String(s string) string { return s }
+
+func f() string {
+	aws := T{}
+	return aws.String("x")
+}
+
+var _ = aws.Config{}
+`,
+			Output: `package p
+
+import "github.com/aws/aws-sdk-go/aws"
+
+type T struct{}
+
+func (T) String(s string) string { return s }
+
+func f() string {
+	aws := T{}
+	return aws.String("x")
+}
+
+var _ = aws.Config{}
+`,
+			Changed: false,
+		},
+		// #7: Nothing to do
+		{
+			Input: `package p
+
+import "fmt"
+
+func f() { fmt.Println("hello") }
+`,
+			Output: `package p
+
+import "fmt"
+
+func f() { fmt.Println("hello") }
+`,
+			Changed: false,
+		},
+	}
+
+	for i, tt := range tests {
+		have, changed, err := rewrite("p.go", []byte(tt.Input))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if changed != tt.Changed {
+			t.Errorf("#%d: have changed = %v, want %v", i, changed, tt.Changed)
+		}
+		if string(have) != tt.Output {
+			t.Errorf("#%d: have\n%s\nwant\n%s", i, have, tt.Output)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\n"
+	b := "a\nb\nc\nD\ne\nf\ng\nh\ni\n"
+	want := `--- a/x.go
++++ b/x.go
+@@ -1,8 +1,9 @@
+ a
+ b
+ c
+-d
++D
+ e
+ f
+ g
+ h
++i
+`
+	if have := unifiedDiff("x.go", []byte(a), []byte(b)); have != want {
+		t.Errorf("have\n%s\nwant\n%s", have, want)
+	}
+	if have := unifiedDiff("x.go", []byte(a), []byte(a)); have != "" {
+		t.Errorf("expected no diff for equal inputs, have\n%s", have)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	// lcs returns the length of the longest common subsequence of x and y.
+	lcs := func(x, y []string) int {
+		l := make([][]int, len(x)+1)
+		for i := range l {
+			l[i] = make([]int, len(y)+1)
+		}
+		for i := len(x) - 1; i >= 0; i-- {
+			for j := len(y) - 1; j >= 0; j-- {
+				if x[i] == y[j] {
+					l[i][j] = l[i+1][j+1] + 1
+				} else {
+					l[i][j] = max(l[i+1][j], l[i][j+1])
+				}
+			}
+		}
+		return l[0][0]
+	}
+	lines := func(r *rand.Rand) []string {
+		s := make([]string, r.IntN(12))
+		for i := range s {
+			s[i] = string(rune('a' + r.IntN(3)))
+		}
+		return s
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 2000; i++ {
+		x, y := lines(r), lines(r)
+		ops := diffLines(x, y)
+
+		var xs, ys []string
+		common := 0
+		for _, op := range ops {
+			if op.kind != '+' {
+				if op.x != len(xs) {
+					t.Fatalf("#%d: diffLines(%v, %v): have x = %d, want %d", i, x, y, op.x, len(xs))
+				}
+				xs = append(xs, op.line)
+			}
+			if op.kind != '-' {
+				if op.y != len(ys) {
+					t.Fatalf("#%d: diffLines(%v, %v): have y = %d, want %d", i, x, y, op.y, len(ys))
+				}
+				ys = append(ys, op.line)
+			}
+			if op.kind == ' ' {
+				common++
+			}
+		}
+		if strings.Join(xs, "") != strings.Join(x, "") || strings.Join(ys, "") != strings.Join(y, "") {
+			t.Fatalf("#%d: diffLines(%v, %v) = %v does not transform x into y", i, x, y, ops)
+		}
+		if want := lcs(x, y); common != want {
+			t.Fatalf("#%d: diffLines(%v, %v) keeps %d lines, want %d", i, x, y, common, want)
+		}
+	}
+}
+
+func BenchmarkUnifiedDiff(b *testing.B) {
+	// A large generated file with a change at the start and the end
+	var sb strings.Builder
+	for i := 0; i < 10000; i++ {
+		sb.WriteString("\t_ = aws.String(\"x\")\n")
+	}
+	a := sb.String()
+	c := strings.Replace(a, "aws.String", "nullable.StringPtr", 1)
+	c = c[:strings.LastIndex(c, "aws.String")] + "nullable.StringPtr(\"x\")\n"
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = unifiedDiff("x.go", []byte(a), []byte(c))
+	}
+}
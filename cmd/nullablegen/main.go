@@ -0,0 +1,458 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+// Nullablegen generates the helpers of package nullable for
+// user-defined types.
+//
+// Given a type OrderID, nullablegen emits the functions OrderID,
+// OrderIDWithDefault, OrderIDPtr, OrderIDSlice and OrderIDPtrSlice,
+// with the same semantics as e.g. nullable.Int, nullable.IntWithDefault
+// and friends, plus a test file for them.
+//
+// Typically nullablegen is invoked via go:generate. Since a function
+// cannot have the same name as a type in the same package, you either
+// generate into a separate package and point -import to the package
+// declaring the types:
+//
+//	//go:generate nullablegen -type=OrderID,Currency -import=example.com/shop/orders
+//
+// or generate into the package declaring the types and use -prefix
+// to name the functions, e.g. NullOrderID, NullOrderIDWithDefault:
+//
+//	//go:generate nullablegen -type=OrderID -prefix=Null
+//
+// The generated code refers to the imported package by the last element
+// of its import path, skipping a major version suffix like /v2 and a
+// go- prefix. Use -importname if the package has a different name.
+//
+// The output is written to <type>_nullable.go and <type>_nullable_test.go,
+// where <type> is the lower-cased name of the first type.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	typeNames  = flag.String("type", "", "comma-separated list of type names; must be set")
+	importPath = flag.String("import", "", "import path of the package declaring the types; empty if it is the output package")
+	importName = flag.String("importname", "", "name of the package declaring the types; default derived from -import")
+	pkgName    = flag.String("package", "", "name of the output package; default $GOPACKAGE or the package in the output directory")
+	prefix     = flag.String("prefix", "", "prefix for the generated function names")
+	output     = flag.String("output", "", "output file name; default <type>_nullable.go")
+	tests      = flag.Bool("tests", true, "generate a test file alongside the output")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of nullablegen:\n")
+	fmt.Fprintf(os.Stderr, "\tnullablegen [flags] -type T [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nullablegen: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if args := flag.Args(); len(args) == 1 {
+		dir = args[0]
+	} else if len(args) > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg := Config{
+		Types:      strings.Split(*typeNames, ","),
+		Import:     *importPath,
+		ImportName: *importName,
+		Package:    *pkgName,
+		Prefix:     *prefix,
+	}
+	if cfg.Package == "" {
+		cfg.Package = os.Getenv("GOPACKAGE")
+	}
+	if cfg.Package == "" {
+		name, err := packageName(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg.Package = name
+	}
+
+	src, testSrc, err := Generate(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	filename := *output
+	if filename == "" {
+		filename = strings.ToLower(cfg.Types[0]) + "_nullable.go"
+	}
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(dir, filename)
+	}
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if *tests {
+		testFilename := strings.TrimSuffix(filename, ".go") + "_test.go"
+		if err := os.WriteFile(testFilename, testSrc, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// packageName returns the name of the (non-test) package in dir.
+func packageName(dir string) (string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	for name := range pkgs {
+		return name, nil
+	}
+	return "", fmt.Errorf("no Go package found in %s; use -package", dir)
+}
+
+// Config specifies what to generate.
+type Config struct {
+	// Types is the list of type names to generate helpers for.
+	Types []string
+	// Import is the import path of the package declaring Types.
+	// It is empty if the types are declared in the output package.
+	Import string
+	// ImportName is the name of the package declaring Types. If it is
+	// empty, it is derived from Import.
+	ImportName string
+	// Package is the name of the output package.
+	Package string
+	// Prefix is prepended to the type name to form the function names.
+	Prefix string
+}
+
+// Generate returns the gofmt-ed source and test source for cfg.
+func Generate(cfg Config) (src []byte, testSrc []byte, err error) {
+	if len(cfg.Types) == 0 {
+		return nil, nil, errors.New("no types specified")
+	}
+	if cfg.Package == "" {
+		return nil, nil, errors.New("no package name specified")
+	}
+	data := struct {
+		Args       string
+		Package    string
+		Import     string
+		ImportName string
+		Types      []typeData
+	}{
+		Args:    strings.Join(append([]string{"nullablegen"}, argsOf(cfg)...), " "),
+		Package: cfg.Package,
+		Import:  cfg.Import,
+	}
+	qualifier := ""
+	if cfg.Import != "" {
+		name := cfg.ImportName
+		if name == "" {
+			name = importPathToName(cfg.Import)
+		}
+		if !token.IsIdentifier(name) {
+			return nil, nil, fmt.Errorf("invalid package name %q for import %q; use -importname", name, cfg.Import)
+		}
+		if name != path.Base(cfg.Import) {
+			// Name the import explicitly, as the reader cannot tell
+			// the package name from the import path.
+			data.ImportName = name
+		}
+		qualifier = name + "."
+	}
+	for _, name := range cfg.Types {
+		name = strings.TrimSpace(name)
+		if !token.IsIdentifier(name) {
+			return nil, nil, fmt.Errorf("invalid type name %q", name)
+		}
+		if cfg.Import == "" && cfg.Prefix == "" {
+			return nil, nil, fmt.Errorf("function %s would collide with type %s; use -prefix or -import", name, name)
+		}
+		data.Types = append(data.Types, typeData{
+			Name: name,
+			Func: cfg.Prefix + name,
+			Type: qualifier + name,
+		})
+	}
+
+	src, err = execute(sourceTemplate, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	testSrc, err = execute(testTemplate, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return src, testSrc, nil
+}
+
+// argsOf returns the command line flags that reproduce cfg.
+func argsOf(cfg Config) []string {
+	args := []string{"-type=" + strings.Join(cfg.Types, ",")}
+	if cfg.Import != "" {
+		args = append(args, "-import="+cfg.Import)
+	}
+	if cfg.ImportName != "" {
+		args = append(args, "-importname="+cfg.ImportName)
+	}
+	if cfg.Prefix != "" {
+		args = append(args, "-prefix="+cfg.Prefix)
+	}
+	return args
+}
+
+// importPathToName returns the assumed name of the package with
+// the given import path, e.g. orders for example.com/go-orders/v2.
+func importPathToName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil {
+			if dir := path.Dir(importPath); dir != "." {
+				base = path.Base(dir)
+			}
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexAny(base, ".-"); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
+
+type typeData struct {
+	Name string // name of the type, e.g. OrderID
+	Func string // base name of the functions, e.g. NullOrderID
+	Type string // qualified type, e.g. orders.OrderID
+}
+
+func execute(t *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+var sourceTemplate = template.Must(template.New("source").Parse(`// Code generated by "{{.Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+{{if .Import}}
+import {{if .ImportName}}{{.ImportName}} {{end}}"{{.Import}}"
+{{end}}
+{{- range .Types}}
+// -- {{.Name}} --
+
+// {{.Func}} returns *v if v is not nil. Otherwise it returns the zero value.
+func {{.Func}}(v *{{.Type}}) {{.Type}} {
+	var zero {{.Type}}
+	return {{.Func}}WithDefault(v, zero)
+}
+
+// {{.Func}}WithDefault returns *v if v is not nil. Otherwise it returns d.
+func {{.Func}}WithDefault(v *{{.Type}}, d {{.Type}}) {{.Type}} {
+	if v == nil {
+		return d
+	}
+	return *v
+}
+
+// {{.Func}}Ptr returns a pointer to v.
+func {{.Func}}Ptr(v {{.Type}}) *{{.Type}} {
+	return &v
+}
+
+// {{.Func}}Slice converts a slice of {{.Name}} pointers to a slice of
+// {{.Name}} values. Elements that are nil are converted to its zero value.
+func {{.Func}}Slice(src []*{{.Type}}) []{{.Type}} {
+	dst := make([]{{.Type}}, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst[i] = *v
+		}
+	}
+	return dst
+}
+
+// {{.Func}}PtrSlice converts a slice of {{.Name}} values to a slice of
+// {{.Name}} pointers.
+func {{.Func}}PtrSlice(src []{{.Type}}) []*{{.Type}} {
+	dst := make([]*{{.Type}}, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+{{end}}`))
+
+var testTemplate = template.Must(template.New("test").Parse(`// Code generated by "{{.Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"reflect"
+	"testing"
+{{- if .Import}}
+
+	{{if .ImportName}}{{.ImportName}} {{end}}"{{.Import}}"
+{{- end}}
+)
+{{range .Types}}
+// -- {{.Name}} --
+
+// nonZero{{.Func}} returns a {{.Name}} that is not the zero value.
+// It skips the test if it cannot build one, e.g. for a struct
+// with unexported fields only.
+func nonZero{{.Func}}(t *testing.T) {{.Type}} {
+	t.Helper()
+	var set func(v reflect.Value) bool
+	set = func(v reflect.Value) bool {
+		switch v.Kind() {
+		case reflect.Bool:
+			v.SetBool(true)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(1)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			v.SetUint(1)
+		case reflect.Float32, reflect.Float64:
+			v.SetFloat(1.5)
+		case reflect.Complex64, reflect.Complex128:
+			v.SetComplex(1)
+		case reflect.String:
+			v.SetString("x")
+		case reflect.Pointer:
+			v.Set(reflect.New(v.Type().Elem()))
+		case reflect.Slice:
+			v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		case reflect.Map:
+			v.Set(reflect.MakeMap(v.Type()))
+		case reflect.Chan:
+			v.Set(reflect.MakeChan(v.Type(), 0))
+		case reflect.Array:
+			return v.Len() > 0 && set(v.Index(0))
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if f := v.Field(i); f.CanSet() && set(f) {
+					return true
+				}
+			}
+			return false
+		default:
+			return false
+		}
+		return true
+	}
+	var v {{.Type}}
+	if !set(reflect.ValueOf(&v).Elem()) {
+		t.Skip("cannot build a non-zero {{.Name}}")
+	}
+	return v
+}
+
+func Test{{.Func}}(t *testing.T) {
+	var zero {{.Type}}
+	v := nonZero{{.Func}}(t)
+	tests := []struct {
+		Input  *{{.Type}}
+		Output {{.Type}}
+	}{
+		{Input: nil, Output: zero},
+		{Input: &zero, Output: zero},
+		{Input: &v, Output: v},
+	}
+
+	for i, tt := range tests {
+		if have, want := {{.Func}}(tt.Input), tt.Output; !reflect.DeepEqual(have, want) {
+			t.Errorf("#%d: have {{.Func}}(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func Test{{.Func}}WithDefault(t *testing.T) {
+	var zero {{.Type}}
+	v := nonZero{{.Func}}(t)
+	tests := []struct {
+		Input   *{{.Type}}
+		Default {{.Type}}
+		Output  {{.Type}}
+	}{
+		{Input: nil, Default: zero, Output: zero},
+		{Input: nil, Default: v, Output: v},
+		{Input: &zero, Default: v, Output: zero},
+		{Input: &v, Default: zero, Output: v},
+	}
+
+	for i, tt := range tests {
+		if have, want := {{.Func}}WithDefault(tt.Input, tt.Default), tt.Output; !reflect.DeepEqual(have, want) {
+			t.Errorf("#%d: have {{.Func}}WithDefault(%v, %v) = %v, want %v", i, tt.Input, tt.Default, have, want)
+		}
+	}
+}
+
+func Test{{.Func}}Ptr(t *testing.T) {
+	v := nonZero{{.Func}}(t)
+	have := {{.Func}}Ptr(v)
+	if have == nil {
+		t.Fatalf("have {{.Func}}Ptr(%v) = nil, want non-nil", v)
+	}
+	if !reflect.DeepEqual(*have, v) {
+		t.Errorf("have *{{.Func}}Ptr(%v) = %v, want %v", v, *have, v)
+	}
+}
+
+func Test{{.Func}}Slice(t *testing.T) {
+	var zero {{.Type}}
+	v := nonZero{{.Func}}(t)
+	have := {{.Func}}Slice([]*{{.Type}}{nil, &v, &zero})
+	want := []{{.Type}}{zero, v, zero}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("have {{.Func}}Slice = %v, want %v", have, want)
+	}
+}
+
+func Test{{.Func}}PtrSlice(t *testing.T) {
+	src := make([]{{.Type}}, 2)
+	src[0] = nonZero{{.Func}}(t)
+	have := {{.Func}}PtrSlice(src)
+	if len(have) != len(src) {
+		t.Fatalf("have len({{.Func}}PtrSlice) = %d, want %d", len(have), len(src))
+	}
+	for j := 0; j < len(have); j++ {
+		if have[j] != &src[j] {
+			t.Errorf("have {{.Func}}PtrSlice[%d] = %p, want %p", j, have[j], &src[j])
+		}
+	}
+}
+{{end}}`))
@@ -0,0 +1,289 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateTypeChecks(t *testing.T) {
+	src, testSrc, err := Generate(Config{
+		Types:   []string{"OrderID", "Status"},
+		Package: "orders",
+		Prefix:  "Null",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const decls = `package orders
+
+type OrderID int64
+
+type Status struct{ Code string }
+`
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for name, s := range map[string]string{
+		"decls.go":              decls,
+		"orderid_nullable.go":   string(src),
+		"orderid_nullable_test": string(testSrc),
+	} {
+		f, err := parser.ParseFile(fset, name, s, 0)
+		if err != nil {
+			t.Fatalf("parsing %s: %v\n%s", name, err, s)
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("orders", fset, files, nil)
+	if err != nil {
+		t.Fatalf("type-checking generated code: %v\n%s", err, src)
+	}
+
+	for _, name := range []string{
+		"NullOrderID",
+		"NullOrderIDWithDefault",
+		"NullOrderIDPtr",
+		"NullOrderIDSlice",
+		"NullOrderIDPtrSlice",
+		"NullStatus",
+		"NullStatusWithDefault",
+		"NullStatusPtr",
+		"NullStatusSlice",
+		"NullStatusPtrSlice",
+		"TestNullOrderID",
+		"TestNullStatusPtrSlice",
+	} {
+		if pkg.Scope().Lookup(name) == nil {
+			t.Errorf("expected generated code to declare %s", name)
+		}
+	}
+}
+
+func TestGenerateWithImport(t *testing.T) {
+	src, testSrc, err := Generate(Config{
+		Types:   []string{"OrderID"},
+		Import:  "example.com/shop/orders",
+		Package: "optional",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{string(src), string(testSrc)} {
+		if !strings.Contains(s, `"example.com/shop/orders"`) {
+			t.Errorf("expected generated code to import the orders package:\n%s", s)
+		}
+	}
+	for _, want := range []string{
+		"func OrderID(v *orders.OrderID) orders.OrderID {",
+		"func OrderIDWithDefault(v *orders.OrderID, d orders.OrderID) orders.OrderID {",
+		"func OrderIDPtr(v orders.OrderID) *orders.OrderID {",
+		"func OrderIDSlice(src []*orders.OrderID) []orders.OrderID {",
+		"func OrderIDPtrSlice(src []orders.OrderID) []*orders.OrderID {",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("expected generated code to contain %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestGeneratedTestsRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go test of generated code in short mode")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	src, testSrc, err := Generate(Config{
+		Types:   []string{"OrderID", "Status", "secret"},
+		Package: "orders",
+		Prefix:  "Null",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const decls = `package orders
+
+type OrderID int64
+
+type Status struct{ Code string }
+
+type secret struct{ code string }
+`
+	run := func(src []byte) (string, error) {
+		dir := t.TempDir()
+		for name, s := range map[string][]byte{
+			"go.mod":                   []byte("module orders\n\ngo 1.24\n"),
+			"decls.go":                 []byte(decls),
+			"orderid_nullable.go":      src,
+			"orderid_nullable_test.go": testSrc,
+		} {
+			if err := os.WriteFile(filepath.Join(dir, name), s, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cmd := exec.Command(gobin, "test", "-v", ".")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+
+	out, err := run(src)
+	if err != nil {
+		t.Fatalf("generated tests failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"--- PASS: TestNullOrderIDWithDefault",
+		"--- PASS: TestNullStatusWithDefault",
+		"--- SKIP: TestNullsecretWithDefault",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, out)
+		}
+	}
+
+	// The generated tests must catch helpers that ignore their input
+	for i, tt := range []struct {
+		Old, New string
+		Test     string
+	}{
+		{"return d\n", "var zero OrderID\n\t\treturn zero\n", "TestNullOrderIDWithDefault"},
+		{"\treturn *v\n}\n\n// NullOrderIDPtr", "\treturn d\n}\n\n// NullOrderIDPtr", "TestNullOrderIDWithDefault"},
+		{"dst[i] = *v\n", "_ = v\n", "TestNullOrderIDSlice"},
+	} {
+		broken := strings.Replace(string(src), tt.Old, tt.New, 1)
+		if broken == string(src) {
+			t.Fatalf("#%d: %q not found in generated code", i, tt.Old)
+		}
+		out, err := run([]byte(broken))
+		if err == nil || !strings.Contains(out, "--- FAIL: "+tt.Test) {
+			t.Errorf("#%d: expected %s to fail:\n%s", i, tt.Test, out)
+		}
+	}
+}
+
+// importerFunc implements types.Importer.
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+func TestGenerateImportName(t *testing.T) {
+	tests := []struct {
+		Import     string
+		ImportName string
+		Package    string // actual name of the imported package
+		Qualifier  string
+		ImportSpec string
+	}{
+		{"example.com/shop/orders", "", "orders", "orders.", `"example.com/shop/orders"`},
+		{"example.com/orders/v2", "", "orders", "orders.", `orders "example.com/orders/v2"`},
+		{"example.com/go-orders", "", "orders", "orders.", `orders "example.com/go-orders"`},
+		{"example.com/orders-api", "", "orders", "orders.", `orders "example.com/orders-api"`},
+		{"example.com/shop/api", "orders", "orders", "orders.", `orders "example.com/shop/api"`},
+	}
+
+	for i, tt := range tests {
+		src, testSrc, err := Generate(Config{
+			Types:      []string{"OrderID"},
+			Import:     tt.Import,
+			ImportName: tt.ImportName,
+			Package:    "optional",
+		})
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want := "func OrderID(v *" + tt.Qualifier + "OrderID) " + tt.Qualifier + "OrderID {"; !strings.Contains(string(src), want) {
+			t.Errorf("#%d: expected generated code to contain %q:\n%s", i, want, src)
+		}
+		for _, s := range []string{string(src), string(testSrc)} {
+			if !strings.Contains(s, tt.ImportSpec) {
+				t.Errorf("#%d: expected generated code to import %s:\n%s", i, tt.ImportSpec, s)
+			}
+		}
+
+		// Type-check the generated code against a package with the actual name
+		fset := token.NewFileSet()
+		decls, err := parser.ParseFile(fset, "decls.go", "package "+tt.Package+"\n\ntype OrderID int64\n", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		imported, err := new(types.Config).Check(tt.Import, fset, []*ast.File{decls}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		std := importer.Default()
+		conf := types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+			if path == tt.Import {
+				return imported, nil
+			}
+			return std.Import(path)
+		})}
+		var files []*ast.File
+		for name, s := range map[string]string{
+			"orderid_nullable.go":      string(src),
+			"orderid_nullable_test.go": string(testSrc),
+		} {
+			f, err := parser.ParseFile(fset, name, s, 0)
+			if err != nil {
+				t.Fatalf("#%d: parsing %s: %v\n%s", i, name, err, s)
+			}
+			files = append(files, f)
+		}
+		if _, err := conf.Check("optional", fset, files, nil); err != nil {
+			t.Errorf("#%d: type-checking generated code: %v\n%s", i, err, src)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		Config Config
+		Error  string
+	}{
+		{
+			Config: Config{Package: "orders"},
+			Error:  "no types specified",
+		},
+		{
+			Config: Config{Types: []string{"OrderID"}, Prefix: "Null"},
+			Error:  "no package name specified",
+		},
+		{
+			Config: Config{Types: []string{"Order-ID"}, Package: "orders", Prefix: "Null"},
+			Error:  `invalid type name "Order-ID"`,
+		},
+		{
+			Config: Config{Types: []string{"OrderID"}, Package: "orders"},
+			Error:  "function OrderID would collide with type OrderID; use -prefix or -import",
+		},
+		{
+			Config: Config{Types: []string{"OrderID"}, Package: "optional", Import: "example.com/shop/orders", ImportName: "shop-orders"},
+			Error:  `invalid package name "shop-orders" for import "example.com/shop/orders"; use -importname`,
+		},
+	}
+
+	for i, tt := range tests {
+		_, _, err := Generate(tt.Config)
+		if err == nil {
+			t.Fatalf("#%d: expected error %q, got nil", i, tt.Error)
+		}
+		if have, want := err.Error(), tt.Error; have != want {
+			t.Errorf("#%d: have error %q, want %q", i, have, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+// Nullablevet checks for misuse of optional values that package
+// nullable is meant to prevent.
+//
+// It can be run standalone or as a vet tool:
+//
+//	go install github.com/olivere/nullable/cmd/nullablevet@latest
+//	go vet -vettool=$(which nullablevet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/olivere/nullable/analysis/nilderef"
+	"github.com/olivere/nullable/analysis/ptrslicealias"
+)
+
+func main() {
+	multichecker.Main(
+		nilderef.Analyzer,
+		ptrslicealias.Analyzer,
+	)
+}
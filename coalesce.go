@@ -0,0 +1,62 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// Coalesce returns the first of ptrs that is not nil, like COALESCE
+// in SQL. It returns nil if all of ptrs are nil.
+func Coalesce[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// CoalesceValue returns the value of the first of ptrs that is not nil.
+// Otherwise it returns d.
+//
+// It generalizes e.g. IntWithDefault to more than one candidate, e.g.
+//
+//	port := CoalesceValue(8080, flagPort, envPort, filePort)
+func CoalesceValue[T any](d T, ptrs ...*T) T {
+	if p := Coalesce(ptrs...); p != nil {
+		return *p
+	}
+	return d
+}
+
+// CoalesceNonZero returns the first of ptrs that is neither nil nor
+// points to the zero value of T. It returns nil if there is no such
+// pointer. Values with an IsZero method, like time.Time, are zero if
+// IsZero returns true.
+func CoalesceNonZero[T comparable](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil && !isZero(*p) {
+			return p
+		}
+	}
+	return nil
+}
+
+// CoalesceNonZeroValue returns the value of the first of ptrs that is
+// neither nil nor points to the zero value of T. Otherwise it returns d.
+func CoalesceNonZeroValue[T comparable](d T, ptrs ...*T) T {
+	if p := CoalesceNonZero(ptrs...); p != nil {
+		return *p
+	}
+	return d
+}
+
+// isZero returns true if v is the zero value of T. If T has an IsZero
+// method, like time.Time, it is used instead of comparing to the zero
+// value, e.g. to ignore the location of a time.
+func isZero[T comparable](v T) bool {
+	if z, ok := any(v).(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+	var zero T
+	return v == zero
+}
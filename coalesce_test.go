@@ -0,0 +1,96 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCoalesce(t *testing.T) {
+	zero := 0
+	one := 1
+	two := 2
+	tests := []struct {
+		Input  []*int
+		Output *int
+	}{
+		{Input: nil, Output: nil},
+		{Input: []*int{nil, nil}, Output: nil},
+		{Input: []*int{&one, &two}, Output: &one},
+		{Input: []*int{nil, &two}, Output: &two},
+		{Input: []*int{nil, &zero, &two}, Output: &zero},
+	}
+
+	for i, tt := range tests {
+		if have, want := Coalesce(tt.Input...), tt.Output; have != want {
+			t.Errorf("#%d: have Coalesce(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestCoalesceValue(t *testing.T) {
+	zero := 0
+	one := 1
+	tests := []struct {
+		Default int
+		Input   []*int
+		Output  int
+	}{
+		{Default: 42, Input: nil, Output: 42},
+		{Default: 42, Input: []*int{nil, nil}, Output: 42},
+		{Default: 42, Input: []*int{nil, &one}, Output: 1},
+		{Default: 42, Input: []*int{nil, &zero, &one}, Output: 0},
+	}
+
+	for i, tt := range tests {
+		if have, want := CoalesceValue(tt.Default, tt.Input...), tt.Output; have != want {
+			t.Errorf("#%d: have CoalesceValue(%v, %v) = %v, want %v", i, tt.Default, tt.Input, have, want)
+		}
+	}
+}
+
+func TestCoalesceNonZero(t *testing.T) {
+	empty := ""
+	one := "one"
+	two := "two"
+	tests := []struct {
+		Input  []*string
+		Output *string
+	}{
+		{Input: nil, Output: nil},
+		{Input: []*string{nil, &empty}, Output: nil},
+		{Input: []*string{nil, &empty, &one, &two}, Output: &one},
+		{Input: []*string{&two, &one}, Output: &two},
+	}
+
+	for i, tt := range tests {
+		if have, want := CoalesceNonZero(tt.Input...), tt.Output; have != want {
+			t.Errorf("#%d: have CoalesceNonZero(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestCoalesceNonZeroValue(t *testing.T) {
+	var zero time.Time
+	zeroCET := zero.In(time.FixedZone("CET", 3600))
+	one := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	two := time.Date(1982, 11, 23, 23, 11, 9, 0, time.UTC)
+	tests := []struct {
+		Default time.Time
+		Input   []*time.Time
+		Output  time.Time
+	}{
+		{Default: two, Input: nil, Output: two},
+		{Default: two, Input: []*time.Time{nil, &zero, &zeroCET}, Output: two},
+		{Default: two, Input: []*time.Time{nil, &zeroCET, &one}, Output: one},
+	}
+
+	for i, tt := range tests {
+		if have, want := CoalesceNonZeroValue(tt.Default, tt.Input...), tt.Output; !have.Equal(want) {
+			t.Errorf("#%d: have CoalesceNonZeroValue(%v, %v) = %v, want %v", i, tt.Default, tt.Input, have, want)
+		}
+	}
+}
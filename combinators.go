@@ -0,0 +1,48 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// Map returns a pointer to f(*v) if v is not nil. Otherwise it returns nil.
+func Map[T, U any](v *T, f func(T) U) *U {
+	if v == nil {
+		return nil
+	}
+	u := f(*v)
+	return &u
+}
+
+// FlatMap returns f(*v) if v is not nil. Otherwise it returns nil.
+func FlatMap[T, U any](v *T, f func(T) *U) *U {
+	if v == nil {
+		return nil
+	}
+	return f(*v)
+}
+
+// Filter returns v if v is not nil and f(*v) returns true.
+// Otherwise it returns nil.
+func Filter[T any](v *T, f func(T) bool) *T {
+	if v == nil || !f(*v) {
+		return nil
+	}
+	return v
+}
+
+// OrElseGet returns *v if v is not nil. Otherwise it returns f().
+// Unlike e.g. IntWithDefault, the default value is only computed
+// if it is needed.
+func OrElseGet[T any](v *T, f func() T) T {
+	if v == nil {
+		return f()
+	}
+	return *v
+}
+
+// IfPresent calls f(*v) if v is not nil.
+func IfPresent[T any](v *T, f func(T)) {
+	if v != nil {
+		f(*v)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	one := 1
+	tests := []struct {
+		Input  *int
+		Output *string
+	}{
+		{Input: nil, Output: nil},
+		{Input: &one, Output: StringPtr("1")},
+	}
+
+	for i, tt := range tests {
+		have, want := Map(tt.Input, strconv.Itoa), tt.Output
+		if (have == nil) != (want == nil) {
+			t.Fatalf("#%d: have Map(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have != nil && *have != *want {
+			t.Errorf("#%d: have Map(%v) = %v, want %v", i, tt.Input, *have, *want)
+		}
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	atoi := func(s string) *int {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil
+		}
+		return &i
+	}
+	tests := []struct {
+		Input  *string
+		Output *int
+	}{
+		{Input: nil, Output: nil},
+		{Input: StringPtr("x"), Output: nil},
+		{Input: StringPtr("42"), Output: IntPtr(42)},
+	}
+
+	for i, tt := range tests {
+		have, want := FlatMap(tt.Input, atoi), tt.Output
+		if (have == nil) != (want == nil) {
+			t.Fatalf("#%d: have FlatMap(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have != nil && *have != *want {
+			t.Errorf("#%d: have FlatMap(%v) = %v, want %v", i, tt.Input, *have, *want)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	one := 1
+	two := 2
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		Input  *int
+		Output *int
+	}{
+		{Input: nil, Output: nil},
+		{Input: &one, Output: nil},
+		{Input: &two, Output: &two},
+	}
+
+	for i, tt := range tests {
+		if have, want := Filter(tt.Input, even), tt.Output; have != want {
+			t.Errorf("#%d: have Filter(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	one := 1
+	tests := []struct {
+		Input  *int
+		Output int
+		Called bool
+	}{
+		{Input: nil, Output: 42, Called: true},
+		{Input: &one, Output: 1, Called: false},
+	}
+
+	for i, tt := range tests {
+		called := false
+		f := func() int {
+			called = true
+			return 42
+		}
+		if have, want := OrElseGet(tt.Input, f), tt.Output; have != want {
+			t.Errorf("#%d: have OrElseGet(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if called != tt.Called {
+			t.Errorf("#%d: have called = %v, want %v", i, called, tt.Called)
+		}
+	}
+}
+
+func TestIfPresent(t *testing.T) {
+	one := 1
+	tests := []struct {
+		Input  *int
+		Output []int
+	}{
+		{Input: nil, Output: nil},
+		{Input: &one, Output: []int{1}},
+	}
+
+	for i, tt := range tests {
+		var have []int
+		IfPresent(tt.Input, func(v int) { have = append(have, v) })
+		if len(have) != len(tt.Output) || (len(have) > 0 && have[0] != tt.Output[0]) {
+			t.Errorf("#%d: have IfPresent(%v) calls = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+}
+
+func TestCombinatorsDoNotAllocateForNil(t *testing.T) {
+	var v *int
+	tests := []struct {
+		Name string
+		F    func()
+	}{
+		{Name: "Map", F: func() { _ = Map(v, strconv.Itoa) }},
+		{Name: "FlatMap", F: func() { _ = FlatMap(v, func(int) *string { return nil }) }},
+		{Name: "Filter", F: func() { _ = Filter(v, func(int) bool { return true }) }},
+		{Name: "OrElseGet", F: func() { _ = OrElseGet(v, func() int { return 1 }) }},
+		{Name: "IfPresent", F: func() { IfPresent(v, func(int) {}) }},
+	}
+
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, tt.F); allocs != 0 {
+			t.Errorf("have %v allocations in %s(nil), want 0", allocs, tt.Name)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import "unsafe"
+
+// Signed is a constraint for signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint for unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint for integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint for floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// isFloat returns true if T is a floating-point type.
+func isFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// isSigned returns true if T is a signed integer
+// or a floating-point type.
+func isSigned[T Number]() bool {
+	var zero T
+	return zero-1 < 0
+}
+
+// bitSize returns the size of T in bits.
+func bitSize[T Number]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import "math"
+
+// Convert converts *v to type To. It returns nil if v is nil.
+//
+// Unlike a conversion like int32(*v), it returns ErrOverflow if *v is
+// out of the range of To, ErrTruncated if *v cannot be represented
+// exactly in To (e.g. 1.5 as an int, or 1<<53+1 as a float64), and
+// ErrNaN when converting NaN to an integer type. Conversions between
+// floating-point types only check the range, e.g. converting a float64
+// to a float32 may lose precision.
+//
+// Example:
+//
+//	id, err := nullable.Convert[int32](req.ID) // req.ID is an *int64
+func Convert[To, From Number](v *From) (*To, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var (
+		to  To
+		err error
+	)
+	switch {
+	case isFloat[From]() && isFloat[To]():
+		to, err = floatToFloat[To](*v)
+	case isFloat[From]():
+		to, err = floatToInt[To](*v)
+	case isFloat[To]():
+		to, err = intToFloat[To](*v)
+	default:
+		to, err = intToInt[To](*v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &to, nil
+}
+
+func floatToFloat[To, From Number](v From) (To, error) {
+	f := float64(v)
+	t := To(v)
+	if math.IsInf(float64(t), 0) && !math.IsInf(f, 0) {
+		return 0, ErrOverflow
+	}
+	return t, nil
+}
+
+func floatToInt[To, From Number](v From) (To, error) {
+	f := float64(v)
+	if math.IsNaN(f) {
+		return 0, ErrNaN
+	}
+	n := bitSize[To]()
+	lo, hi := 0.0, math.Ldexp(1, n)
+	if isSigned[To]() {
+		lo, hi = -math.Ldexp(1, n-1), math.Ldexp(1, n-1)
+	}
+	if f < lo || f >= hi {
+		return 0, ErrOverflow
+	}
+	t := To(f)
+	if float64(t) != f {
+		return 0, ErrTruncated
+	}
+	return t, nil
+}
+
+func intToFloat[To, From Number](v From) (To, error) {
+	t := To(v)
+	f := float64(t)
+	// f is an integral value now; check that it is exactly v by converting
+	// it back, taking care to not convert values outside of the range of
+	// int64 or uint64.
+	if isSigned[From]() {
+		if f < -(1<<63) || f >= 1<<63 || int64(f) != int64(v) {
+			return 0, ErrTruncated
+		}
+	} else {
+		if f >= 1<<64 || uint64(f) != uint64(v) {
+			return 0, ErrTruncated
+		}
+	}
+	return t, nil
+}
+
+func intToInt[To, From Number](v From) (To, error) {
+	t := To(v)
+	if From(t) != v || (t < 0) != (v < 0) {
+		return 0, ErrOverflow
+	}
+	return t, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestConvertNil(t *testing.T) {
+	have, err := Convert[int32]((*int64)(nil))
+	if err != nil {
+		t.Fatalf("have Convert[int32](nil) error = %v, want nil", err)
+	}
+	if have != nil {
+		t.Errorf("have Convert[int32](nil) = %v, want nil", have)
+	}
+}
+
+func TestConvertInt64ToInt32(t *testing.T) {
+	tests := []struct {
+		Input  int64
+		Output int32
+		Error  error
+	}{
+		{Input: 42, Output: 42},
+		{Input: math.MaxInt32, Output: math.MaxInt32},
+		{Input: math.MinInt32, Output: math.MinInt32},
+		{Input: math.MaxInt32 + 1, Error: ErrOverflow},
+		{Input: math.MinInt32 - 1, Error: ErrOverflow},
+		{Input: math.MaxInt64, Error: ErrOverflow},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[int32](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[int32](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[int32](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+}
+
+func TestConvertIntToUnsigned(t *testing.T) {
+	tests := []struct {
+		Input  int
+		Output uint8
+		Error  error
+	}{
+		{Input: 0, Output: 0},
+		{Input: 255, Output: 255},
+		{Input: 256, Error: ErrOverflow},
+		{Input: -1, Error: ErrOverflow},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[uint8](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[uint8](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[uint8](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+}
+
+func TestConvertFloat64ToInt(t *testing.T) {
+	tests := []struct {
+		Input  float64
+		Output int64
+		Error  error
+	}{
+		{Input: 0, Output: 0},
+		{Input: 42, Output: 42},
+		{Input: -42, Output: -42},
+		{Input: -(1 << 63), Output: math.MinInt64},
+		{Input: 1 << 63, Error: ErrOverflow},
+		{Input: 1e300, Error: ErrOverflow},
+		{Input: math.Inf(+1), Error: ErrOverflow},
+		{Input: math.Inf(-1), Error: ErrOverflow},
+		{Input: 1.5, Error: ErrTruncated},
+		{Input: -0.5, Error: ErrTruncated},
+		{Input: math.NaN(), Error: ErrNaN},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[int64](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[int64](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[int64](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+}
+
+func TestConvertFloat32ToInt32(t *testing.T) {
+	tests := []struct {
+		Input  float32
+		Output int32
+		Error  error
+	}{
+		{Input: 16777216, Output: 16777216},
+		{Input: -(1 << 31), Output: math.MinInt32},
+		{Input: 1 << 31, Error: ErrOverflow},
+		{Input: 0.25, Error: ErrTruncated},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[int32](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[int32](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[int32](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+}
+
+func TestConvertIntToFloat(t *testing.T) {
+	tests := []struct {
+		Input  int64
+		Output float64
+		Error  error
+	}{
+		{Input: 42, Output: 42},
+		{Input: 1 << 53, Output: 1 << 53},
+		{Input: -(1 << 53), Output: -(1 << 53)},
+		{Input: 1<<53 + 1, Error: ErrTruncated},
+		{Input: math.MaxInt64, Error: ErrTruncated},
+		{Input: math.MinInt64, Output: -(1 << 63)},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[float64](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[float64](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[float64](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+
+	var u uint64 = math.MaxUint64
+	if _, err := Convert[float64](&u); !errors.Is(err, ErrTruncated) {
+		t.Errorf("have Convert[float64](%v) error = %v, want %v", u, err, ErrTruncated)
+	}
+	var i32 int32 = 1<<24 + 1
+	if _, err := Convert[float32](&i32); !errors.Is(err, ErrTruncated) {
+		t.Errorf("have Convert[float32](%v) error = %v, want %v", i32, err, ErrTruncated)
+	}
+}
+
+func TestConvertFloat64ToFloat32(t *testing.T) {
+	tests := []struct {
+		Input  float64
+		Output float32
+		Error  error
+	}{
+		{Input: 1.5, Output: 1.5},
+		{Input: 0.1, Output: 0.1},
+		{Input: math.Inf(-1), Output: float32(math.Inf(-1))},
+		{Input: 1e39, Error: ErrOverflow},
+		{Input: -1e39, Error: ErrOverflow},
+	}
+
+	for i, tt := range tests {
+		have, err := Convert[float32](&tt.Input)
+		if !errors.Is(err, tt.Error) {
+			t.Fatalf("#%d: have Convert[float32](%v) error = %v, want %v", i, tt.Input, err, tt.Error)
+		}
+		if err == nil && (have == nil || *have != tt.Output) {
+			t.Errorf("#%d: have Convert[float32](%v) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+
+	nan := math.NaN()
+	if have, err := Convert[float32](&nan); err != nil || have == nil || !math.IsNaN(float64(*have)) {
+		t.Errorf("have Convert[float32](NaN) = %v, %v, want NaN, nil", have, err)
+	}
+}
+
+func TestConvertDuration(t *testing.T) {
+	d := 3 * time.Second
+	have, err := Convert[int64](&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int64(3e9); have == nil || *have != want {
+		t.Errorf("have Convert[int64](%v) = %v, want %v", d, have, want)
+	}
+}
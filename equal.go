@@ -0,0 +1,159 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"cmp"
+	"time"
+)
+
+// Equal returns true if a and b are both nil, or if both are not nil
+// and *a == *b. Notice that a == b compares addresses, not values.
+//
+// If T has an Equal(T) bool method, like time.Time, Equal uses it
+// to compare the values instead of ==.
+func Equal[T comparable](a, b *T) bool {
+	return EqualFunc(a, b, equal[T])
+}
+
+// equal returns x.Equal(y) if T has such a method, and x == y otherwise.
+func equal[T comparable](x, y T) bool {
+	if e, ok := any(x).(interface{ Equal(T) bool }); ok {
+		return e.Equal(y)
+	}
+	return x == y
+}
+
+// EqualFunc is like Equal but uses eq to compare the values
+// if both a and b are not nil.
+func EqualFunc[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return eq(*a, *b)
+}
+
+// TimeEqual compares the times with time.Time.Equal, i.e. two times in
+// different locations are equal if they represent the same time instant.
+// It is equivalent to Equal.
+func TimeEqual(a, b *time.Time) bool {
+	return EqualFunc(a, b, time.Time.Equal)
+}
+
+// NilOrder specifies whether nil values are ordered before or after
+// non-nil values.
+type NilOrder int
+
+const (
+	// NilsFirst orders nil values before non-nil values.
+	NilsFirst NilOrder = iota
+	// NilsLast orders nil values after non-nil values.
+	NilsLast
+)
+
+// Compare returns
+//
+//	-1 if a is less than b,
+//	 0 if a equals b,
+//	+1 if a is greater than b.
+//
+// Two nil values are equal. A nil value is less than a non-nil value
+// if order is NilsFirst, and greater otherwise. Non-nil values are
+// compared with cmp.Compare.
+func Compare[T cmp.Ordered](a, b *T, order NilOrder) int {
+	return CompareFunc(a, b, order, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but uses f to compare the values
+// if both a and b are not nil.
+func CompareFunc[T any](a, b *T, order NilOrder, f func(T, T) int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		if order == NilsLast {
+			return +1
+		}
+		return -1
+	case b == nil:
+		if order == NilsLast {
+			return -1
+		}
+		return +1
+	}
+	return f(*a, *b)
+}
+
+// TimeCompare is like Compare but compares the times with
+// time.Time.Compare.
+func TimeCompare(a, b *time.Time, order NilOrder) int {
+	return CompareFunc(a, b, order, time.Time.Compare)
+}
+
+// SliceEqual returns true if a and b have the same length and
+// Equal returns true for all elements.
+func SliceEqual[T comparable](a, b []*T) bool {
+	return SliceEqualFunc(a, b, equal[T])
+}
+
+// SliceEqualFunc is like SliceEqual but uses EqualFunc with eq
+// to compare the elements.
+func SliceEqualFunc[T any](a, b []*T, eq func(T, T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualFunc(a[i], b[i], eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// TimeSliceEqual compares the elements with TimeEqual.
+// It is equivalent to SliceEqual.
+func TimeSliceEqual(a, b []*time.Time) bool {
+	return SliceEqualFunc(a, b, time.Time.Equal)
+}
+
+// SliceCompare compares the elements of a and b with Compare, in order,
+// and returns the result of the first comparison that is not 0.
+// If all elements are equal, the shorter slice is less than the longer.
+func SliceCompare[T cmp.Ordered](a, b []*T, order NilOrder) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := Compare(a[i], b[i], order); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// MapEqual returns true if a and b contain the same keys and Equal
+// returns true for the values of all keys. A key with a nil value
+// is not equal to a missing key.
+func MapEqual[K, V comparable](a, b map[K]*V) bool {
+	return MapEqualFunc(a, b, equal[V])
+}
+
+// MapEqualFunc is like MapEqual but uses EqualFunc with eq
+// to compare the values.
+func MapEqualFunc[K comparable, V any](a, b map[K]*V, eq func(V, V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, x := range a {
+		y, ok := b[k]
+		if !ok || !EqualFunc(x, y, eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// TimeMapEqual compares the values with TimeEqual.
+// It is equivalent to MapEqual.
+func TimeMapEqual[K comparable](a, b map[K]*time.Time) bool {
+	return MapEqualFunc(a, b, time.Time.Equal)
+}
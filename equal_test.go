@@ -0,0 +1,226 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		A, B   *string
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: StringPtr("a"), B: nil, Output: false},
+		{A: nil, B: StringPtr("a"), Output: false},
+		{A: StringPtr("a"), B: StringPtr("a"), Output: true},
+		{A: StringPtr("a"), B: StringPtr("b"), Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := Equal(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have Equal(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestEqualUsesEqualMethod(t *testing.T) {
+	utc := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	cet := utc.In(time.FixedZone("CET", 3600))
+	now := time.Now() // has a monotonic clock reading
+	tests := []struct {
+		A, B   *time.Time
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: &utc, B: nil, Output: false},
+		{A: &utc, B: &cet, Output: true},
+		{A: &now, B: TimePtr(now.Round(0)), Output: true},
+		{A: &utc, B: TimePtr(utc.Add(time.Second)), Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := Equal(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have Equal(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+		a, b := []*time.Time{nil, tt.A}, []*time.Time{nil, tt.B}
+		if have, want := SliceEqual(a, b), tt.Output; have != want {
+			t.Errorf("#%d: have SliceEqual(%v, %v) = %v, want %v", i, a, b, have, want)
+		}
+		m, n := map[string]*time.Time{"a": tt.A}, map[string]*time.Time{"a": tt.B}
+		if have, want := MapEqual(m, n), tt.Output; have != want {
+			t.Errorf("#%d: have MapEqual(%v, %v) = %v, want %v", i, m, n, have, want)
+		}
+	}
+}
+
+func TestTimeEqual(t *testing.T) {
+	utc := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	cet := utc.In(time.FixedZone("CET", 3600))
+	tests := []struct {
+		A, B   *time.Time
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: &utc, B: nil, Output: false},
+		{A: nil, B: &utc, Output: false},
+		{A: &utc, B: &cet, Output: true},
+		{A: &utc, B: TimePtr(utc.Add(time.Second)), Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := TimeEqual(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have TimeEqual(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		A, B   *int
+		Order  NilOrder
+		Output int
+	}{
+		{A: nil, B: nil, Order: NilsFirst, Output: 0},
+		{A: nil, B: nil, Order: NilsLast, Output: 0},
+		{A: nil, B: IntPtr(1), Order: NilsFirst, Output: -1},
+		{A: nil, B: IntPtr(1), Order: NilsLast, Output: +1},
+		{A: IntPtr(1), B: nil, Order: NilsFirst, Output: +1},
+		{A: IntPtr(1), B: nil, Order: NilsLast, Output: -1},
+		{A: IntPtr(1), B: IntPtr(2), Order: NilsFirst, Output: -1},
+		{A: IntPtr(2), B: IntPtr(1), Order: NilsLast, Output: +1},
+		{A: IntPtr(2), B: IntPtr(2), Order: NilsLast, Output: 0},
+	}
+
+	for i, tt := range tests {
+		if have, want := Compare(tt.A, tt.B, tt.Order), tt.Output; have != want {
+			t.Errorf("#%d: have Compare(%v, %v, %v) = %v, want %v", i, tt.A, tt.B, tt.Order, have, want)
+		}
+	}
+}
+
+func TestTimeCompare(t *testing.T) {
+	utc := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	cet := utc.In(time.FixedZone("CET", 3600))
+	later := utc.Add(time.Second)
+	tests := []struct {
+		A, B   *time.Time
+		Order  NilOrder
+		Output int
+	}{
+		{A: nil, B: nil, Order: NilsFirst, Output: 0},
+		{A: nil, B: &utc, Order: NilsFirst, Output: -1},
+		{A: nil, B: &utc, Order: NilsLast, Output: +1},
+		{A: &utc, B: &cet, Order: NilsFirst, Output: 0},
+		{A: &utc, B: &later, Order: NilsFirst, Output: -1},
+		{A: &later, B: &cet, Order: NilsFirst, Output: +1},
+	}
+
+	for i, tt := range tests {
+		if have, want := TimeCompare(tt.A, tt.B, tt.Order), tt.Output; have != want {
+			t.Errorf("#%d: have TimeCompare(%v, %v, %v) = %v, want %v", i, tt.A, tt.B, tt.Order, have, want)
+		}
+	}
+}
+
+func TestSliceEqual(t *testing.T) {
+	tests := []struct {
+		A, B   []*int
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: nil, B: []*int{}, Output: true},
+		{A: []*int{nil}, B: []*int{}, Output: false},
+		{A: []*int{nil, IntPtr(1)}, B: []*int{nil, IntPtr(1)}, Output: true},
+		{A: []*int{nil, IntPtr(1)}, B: []*int{IntPtr(1), nil}, Output: false},
+		{A: []*int{IntPtr(1)}, B: []*int{IntPtr(2)}, Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := SliceEqual(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have SliceEqual(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestTimeSliceEqual(t *testing.T) {
+	utc := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	cet := utc.In(time.FixedZone("CET", 3600))
+	tests := []struct {
+		A, B   []*time.Time
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: []*time.Time{nil, &utc}, B: []*time.Time{nil, &cet}, Output: true},
+		{A: []*time.Time{&utc}, B: []*time.Time{nil}, Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := TimeSliceEqual(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have TimeSliceEqual(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestSliceCompare(t *testing.T) {
+	tests := []struct {
+		A, B   []*int
+		Order  NilOrder
+		Output int
+	}{
+		{A: nil, B: nil, Order: NilsFirst, Output: 0},
+		{A: []*int{IntPtr(1)}, B: []*int{IntPtr(1), nil}, Order: NilsFirst, Output: -1},
+		{A: []*int{nil}, B: []*int{IntPtr(1)}, Order: NilsFirst, Output: -1},
+		{A: []*int{nil}, B: []*int{IntPtr(1)}, Order: NilsLast, Output: +1},
+		{A: []*int{IntPtr(1), IntPtr(3)}, B: []*int{IntPtr(1), IntPtr(2)}, Order: NilsLast, Output: +1},
+	}
+
+	for i, tt := range tests {
+		if have, want := SliceCompare(tt.A, tt.B, tt.Order), tt.Output; have != want {
+			t.Errorf("#%d: have SliceCompare(%v, %v, %v) = %v, want %v", i, tt.A, tt.B, tt.Order, have, want)
+		}
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	tests := []struct {
+		A, B   map[string]*int
+		Output bool
+	}{
+		{A: nil, B: nil, Output: true},
+		{A: nil, B: map[string]*int{}, Output: true},
+		{A: map[string]*int{"a": nil}, B: map[string]*int{}, Output: false},
+		{A: map[string]*int{"a": nil}, B: map[string]*int{"b": nil}, Output: false},
+		{A: map[string]*int{"a": nil, "b": IntPtr(1)}, B: map[string]*int{"a": nil, "b": IntPtr(1)}, Output: true},
+		{A: map[string]*int{"a": IntPtr(1)}, B: map[string]*int{"a": IntPtr(2)}, Output: false},
+		{A: map[string]*int{"a": IntPtr(1)}, B: map[string]*int{"a": nil}, Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := MapEqual(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have MapEqual(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestTimeMapEqual(t *testing.T) {
+	utc := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	cet := utc.In(time.FixedZone("CET", 3600))
+	tests := []struct {
+		A, B   map[string]*time.Time
+		Output bool
+	}{
+		{A: map[string]*time.Time{"a": &utc}, B: map[string]*time.Time{"a": &cet}, Output: true},
+		{A: map[string]*time.Time{"a": &utc}, B: map[string]*time.Time{"a": nil}, Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := TimeMapEqual(tt.A, tt.B), tt.Output; have != want {
+			t.Errorf("#%d: have TimeMapEqual(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import "errors"
+
+var (
+	// ErrOverflow is returned if a value is out of the range
+	// of the target type.
+	ErrOverflow = errors.New("nullable: value out of range")
+
+	// ErrTruncated is returned if a value cannot be represented
+	// exactly in the target type, e.g. when converting 1.5 to an int.
+	ErrTruncated = errors.New("nullable: value truncated")
+
+	// ErrNaN is returned when converting NaN to an integer type.
+	ErrNaN = errors.New("nullable: value is NaN")
+
+	// ErrDivisionByZero is returned when dividing an integer by zero.
+	ErrDivisionByZero = errors.New("nullable: division by zero")
+
+	// ErrNil is returned if a value is required but nil.
+	// Use errors.Is to check for it, as it is usually
+	// wrapped in a *NilError.
+	ErrNil = errors.New("nullable: value is nil")
+)
@@ -1 +1,3 @@
 module github.com/olivere/nullable
+
+go 1.24
@@ -0,0 +1,127 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Interner returns shared *string values for equal strings. Unlike
+// StringPtr, which allocates a new string pointer for every call,
+// it allocates only one per distinct string, which saves memory when
+// e.g. decoding many repeated, enum-like values.
+//
+// As the pointers are shared, the values they point to must not be
+// modified. Use StringPtr if you need a pointer you can write to.
+//
+// The zero value is an empty Interner without a size limit.
+// An Interner is safe for concurrent use. It must not be copied
+// after first use.
+type Interner struct {
+	maxSize int
+
+	mu      sync.RWMutex
+	strings map[string]*string
+
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+	rejected atomic.Uint64
+}
+
+// InternerStats contains statistics about an Interner.
+type InternerStats struct {
+	// Size is the number of distinct strings in the Interner.
+	Size int
+	// Hits is the number of calls that returned a shared pointer
+	// to an existing string.
+	Hits uint64
+	// Misses is the number of calls for strings that were not
+	// in the Interner yet.
+	Misses uint64
+	// Rejected is the number of misses that were not added to the
+	// Interner because it was full. It is included in Misses.
+	Rejected uint64
+}
+
+// NewInterner returns a new Interner that holds up to maxSize distinct
+// strings. When the Interner is full, StringPtr returns unshared
+// pointers for new strings. If maxSize is not positive, the size
+// of the Interner is not limited.
+func NewInterner(maxSize int) *Interner {
+	return &Interner{
+		maxSize: maxSize,
+		strings: make(map[string]*string),
+	}
+}
+
+// StringPtr returns a shared pointer to a string equal to v.
+func (i *Interner) StringPtr(v string) *string {
+	i.mu.RLock()
+	p, ok := i.strings[v]
+	i.mu.RUnlock()
+	if ok {
+		i.hits.Add(1)
+		return p
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if p, ok := i.strings[v]; ok {
+		// Added concurrently since we checked above
+		i.hits.Add(1)
+		return p
+	}
+	i.misses.Add(1)
+	if i.maxSize > 0 && len(i.strings) >= i.maxSize {
+		i.rejected.Add(1)
+		return StringPtr(v)
+	}
+	if i.strings == nil {
+		i.strings = make(map[string]*string)
+	}
+	// Clone v to not retain e.g. the buffer v has been sliced from.
+	s := strings.Clone(v)
+	p = &s
+	i.strings[s] = p
+	return p
+}
+
+// StringPtrSlice converts a slice of string values to a slice of
+// shared string pointers. Unlike StringPtrSlice, the pointers do
+// not point into src.
+func (i *Interner) StringPtrSlice(src []string) []*string {
+	dst := make([]*string, len(src))
+	for j := 0; j < len(src); j++ {
+		dst[j] = i.StringPtr(src[j])
+	}
+	return dst
+}
+
+// Stats returns statistics about the Interner.
+func (i *Interner) Stats() InternerStats {
+	i.mu.RLock()
+	size := len(i.strings)
+	i.mu.RUnlock()
+	return InternerStats{
+		Size:     size,
+		Hits:     i.hits.Load(),
+		Misses:   i.misses.Load(),
+		Rejected: i.rejected.Load(),
+	}
+}
+
+// Reset removes all strings from the Interner and resets its statistics.
+// Pointers returned before remain valid but are no longer shared with
+// pointers returned after Reset.
+func (i *Interner) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	clear(i.strings)
+	i.hits.Store(0)
+	i.misses.Store(0)
+	i.rejected.Store(0)
+}
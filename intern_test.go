@@ -0,0 +1,137 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestInterner(t *testing.T) {
+	in := NewInterner(0)
+	a1 := in.StringPtr("active")
+	a2 := in.StringPtr("active")
+	d := in.StringPtr("deleted")
+
+	if a1 == nil || *a1 != "active" {
+		t.Fatalf("have StringPtr(%q) = %v, want %q", "active", a1, "active")
+	}
+	if a1 != a2 {
+		t.Errorf("expected StringPtr to return the same pointer for equal strings")
+	}
+	if a1 == d || *d != "deleted" {
+		t.Errorf("expected StringPtr to return a different pointer for different strings")
+	}
+
+	want := InternerStats{Size: 2, Hits: 1, Misses: 2}
+	if have := in.Stats(); have != want {
+		t.Errorf("have Stats() = %+v, want %+v", have, want)
+	}
+
+	in.Reset()
+	if have, want := in.Stats(), (InternerStats{}); have != want {
+		t.Errorf("have Stats() after Reset = %+v, want %+v", have, want)
+	}
+	if in.StringPtr("active") == a1 {
+		t.Errorf("expected StringPtr after Reset to return a new pointer")
+	}
+}
+
+func TestInternerZeroValue(t *testing.T) {
+	var in Interner
+	if have := in.Stats(); have != (InternerStats{}) {
+		t.Fatalf("have Stats() = %+v, want %+v", have, InternerStats{})
+	}
+	a1 := in.StringPtr("a")
+	a2 := in.StringPtr("a")
+	if a1 == nil || *a1 != "a" || a1 != a2 {
+		t.Fatalf("expected StringPtr to return shared pointers to %q, have %p and %p", "a", a1, a2)
+	}
+	in.Reset()
+	if have, want := in.Stats().Size, 0; have != want {
+		t.Errorf("have Stats().Size after Reset = %d, want %d", have, want)
+	}
+}
+
+func TestInternerMaxSize(t *testing.T) {
+	in := NewInterner(2)
+	a := in.StringPtr("a")
+	b := in.StringPtr("b")
+	c1 := in.StringPtr("c")
+	c2 := in.StringPtr("c")
+
+	if *c1 != "c" || *c2 != "c" {
+		t.Fatalf("have StringPtr(%q) = %q and %q, want %q", "c", *c1, *c2, "c")
+	}
+	if c1 == c2 {
+		t.Errorf("expected StringPtr to return unshared pointers when full")
+	}
+	if in.StringPtr("a") != a || in.StringPtr("b") != b {
+		t.Errorf("expected StringPtr to return shared pointers for existing strings when full")
+	}
+
+	want := InternerStats{Size: 2, Hits: 2, Misses: 4, Rejected: 2}
+	if have := in.Stats(); have != want {
+		t.Errorf("have Stats() = %+v, want %+v", have, want)
+	}
+}
+
+func TestInternerStringPtrSlice(t *testing.T) {
+	in := NewInterner(0)
+	src := []string{"a", "b", "a"}
+	have := in.StringPtrSlice(src)
+	if want := []*string{StringPtr("a"), StringPtr("b"), StringPtr("a")}; !SliceEqual(have, want) {
+		t.Fatalf("have StringPtrSlice(%v) = %v, want %v", src, have, want)
+	}
+	if have[0] != have[2] {
+		t.Errorf("expected StringPtrSlice to share pointers for equal strings")
+	}
+	if have[0] == &src[0] {
+		t.Errorf("expected StringPtrSlice to not point into src")
+	}
+}
+
+func TestInternerConcurrent(t *testing.T) {
+	in := NewInterner(0)
+	const goroutines = 8
+	results := make([][]*string, goroutines)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				results[g] = append(results[g], in.StringPtr(strconv.Itoa(i%10)))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for g := 1; g < goroutines; g++ {
+		for i := range results[g] {
+			if results[g][i] != results[0][i] {
+				t.Fatalf("expected goroutines to share pointers for %q", *results[0][i])
+			}
+		}
+	}
+	stats := in.Stats()
+	if have, want := stats.Size, 10; have != want {
+		t.Errorf("have Stats().Size = %d, want %d", have, want)
+	}
+	if have, want := stats.Hits+stats.Misses, uint64(goroutines*100); have != want {
+		t.Errorf("have Stats().Hits+Misses = %d, want %d", have, want)
+	}
+}
+
+func BenchmarkInternerStringPtr(b *testing.B) {
+	in := NewInterner(0)
+	values := []string{"active", "inactive", "deleted", "pending"}
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		_ = in.StringPtr(values[i%len(values)])
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import "iter"
+
+// Values returns an iterator over the values of the non-nil pointers
+// in seq. Nil pointers are skipped. Use it with slices.Values to
+// iterate over a slice of pointers, e.g.
+//
+//	for v := range nullable.Values(slices.Values(src)) {
+//		...
+//	}
+func Values[T any](seq iter.Seq[*T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if v != nil && !yield(*v) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesWithDefault returns an iterator over the values of the
+// pointers in seq, yielding d for nil pointers. It is the lazy
+// equivalent of e.g. IntSlice, which yields the zero value for nil.
+func ValuesWithDefault[T any](seq iter.Seq[*T], d T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if v == nil {
+				if !yield(d) {
+					return
+				}
+			} else if !yield(*v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indexes and elements of src,
+// including nil elements.
+func All[T any](src []*T) iter.Seq2[int, *T] {
+	return func(yield func(int, *T) bool) {
+		for i, v := range src {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect collects the values of seq into a new slice and returns
+// a slice of pointers to its elements, like e.g. IntPtrSlice.
+func Collect[T any](seq iter.Seq[T]) []*T {
+	var values []T
+	for v := range seq {
+		values = append(values, v)
+	}
+	dst := make([]*T, len(values))
+	for i := 0; i < len(values); i++ {
+		dst[i] = &(values[i])
+	}
+	return dst
+}
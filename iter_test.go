@@ -0,0 +1,142 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		Input  []*int
+		Output []int
+	}{
+		{Input: nil, Output: nil},
+		{Input: []*int{nil, nil}, Output: nil},
+		{Input: []*int{IntPtr(1), nil, IntPtr(2)}, Output: []int{1, 2}},
+	}
+
+	for i, tt := range tests {
+		have := slices.Collect(Values(slices.Values(tt.Input)))
+		if want := tt.Output; !slices.Equal(have, want) {
+			t.Errorf("#%d: have Values(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestValuesFromMap(t *testing.T) {
+	m := map[string]*string{"a": StringPtr("one"), "b": nil, "c": StringPtr("two")}
+	have := slices.Sorted(Values(maps.Values(m)))
+	if want := []string{"one", "two"}; !slices.Equal(have, want) {
+		t.Errorf("have Values(%v) = %v, want %v", m, have, want)
+	}
+}
+
+func TestValuesWithDefault(t *testing.T) {
+	tests := []struct {
+		Input   []*string
+		Default string
+		Output  []string
+	}{
+		{Input: nil, Default: "", Output: nil},
+		{Input: []*string{StringPtr("one"), nil}, Default: "", Output: []string{"one", ""}},
+		{Input: []*string{nil, StringPtr("two")}, Default: "-", Output: []string{"-", "two"}},
+	}
+
+	for i, tt := range tests {
+		have := slices.Collect(ValuesWithDefault(slices.Values(tt.Input), tt.Default))
+		if want := tt.Output; !slices.Equal(have, want) {
+			t.Errorf("#%d: have ValuesWithDefault(%v, %q) = %v, want %v", i, tt.Input, tt.Default, have, want)
+		}
+	}
+}
+
+func TestIteratorsStopEarly(t *testing.T) {
+	input := []*int{IntPtr(1), nil, IntPtr(2), IntPtr(3)}
+
+	var values []int
+	for v := range Values(slices.Values(input)) {
+		values = append(values, v)
+		if v == 2 {
+			break
+		}
+	}
+	if want := []int{1, 2}; !slices.Equal(values, want) {
+		t.Errorf("have Values with break = %v, want %v", values, want)
+	}
+
+	values = nil
+	for v := range ValuesWithDefault(slices.Values(input), 0) {
+		values = append(values, v)
+		if v == 0 {
+			break
+		}
+	}
+	if want := []int{1, 0}; !slices.Equal(values, want) {
+		t.Errorf("have ValuesWithDefault with break = %v, want %v", values, want)
+	}
+
+	var indexes []int
+	for i := range All(input) {
+		indexes = append(indexes, i)
+		if i == 1 {
+			break
+		}
+	}
+	if want := []int{0, 1}; !slices.Equal(indexes, want) {
+		t.Errorf("have All with break = %v, want %v", indexes, want)
+	}
+}
+
+func TestAll(t *testing.T) {
+	input := []*int{IntPtr(1), nil, IntPtr(2)}
+	var (
+		indexes []int
+		ptrs    []*int
+	)
+	for i, v := range All(input) {
+		indexes = append(indexes, i)
+		ptrs = append(ptrs, v)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(indexes, want) {
+		t.Errorf("have All indexes = %v, want %v", indexes, want)
+	}
+	if !slices.Equal(ptrs, input) {
+		t.Errorf("have All elements = %v, want %v", ptrs, input)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		Input  []string
+		Output []*string
+	}{
+		{Input: nil, Output: []*string{}},
+		{Input: []string{"one", "two"}, Output: []*string{StringPtr("one"), StringPtr("two")}},
+	}
+
+	for i, tt := range tests {
+		have := Collect(slices.Values(tt.Input))
+		if want := tt.Output; !SliceEqual(have, want) {
+			t.Errorf("#%d: have Collect(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestValuesDoesNotAllocate(t *testing.T) {
+	input := []*int{IntPtr(1), nil, IntPtr(2)}
+	allocs := testing.AllocsPerRun(100, func() {
+		sum := 0
+		for v := range Values(slices.Values(input)) {
+			sum += v
+		}
+		_ = sum
+	})
+	if allocs != 0 {
+		t.Errorf("have %v allocations iterating with Values, want 0", allocs)
+	}
+}
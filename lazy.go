@@ -0,0 +1,68 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"sync"
+)
+
+// Lazy is a value that is computed on first access and then cached.
+// Use it like OrElseGet for defaults that are expensive to compute
+// and needed more than once.
+//
+// A Lazy is safe for concurrent use. It must not be copied after
+// first use.
+type Lazy[T any] struct {
+	fn func() (T, error)
+
+	mu       sync.Mutex
+	computed bool
+	v        T
+	err      error
+}
+
+// NewLazy returns a Lazy that computes its value with fn.
+func NewLazy[T any](fn func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// Get returns the value, computing it if necessary. If fn returned
+// an error, Get returns that error, and fn is not called again until
+// Reset. Concurrent calls wait for a single call of fn.
+func (l *Lazy[T]) Get() (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.computed {
+		l.v, l.err = l.fn()
+		l.computed = true
+	}
+	return l.v, l.err
+}
+
+// GetOr returns the value, computing it if necessary.
+// If fn returned an error, it returns d.
+func (l *Lazy[T]) GetOr(d T) T {
+	v, err := l.Get()
+	if err != nil {
+		return d
+	}
+	return v
+}
+
+// IsComputed reports whether the value or error has been computed.
+func (l *Lazy[T]) IsComputed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.computed
+}
+
+// Reset discards the cached value or error, so the next call to
+// Get computes it again.
+func (l *Lazy[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var v T
+	l.computed, l.v, l.err = false, v, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	l := NewLazy(func() (string, error) {
+		calls++
+		return "example.com", nil
+	})
+
+	if l.IsComputed() {
+		t.Fatal("expected IsComputed to return false before Get")
+	}
+	for i := 0; i < 3; i++ {
+		if have, err := l.Get(); err != nil || have != "example.com" {
+			t.Fatalf("#%d: have Get() = (%q, %v), want (%q, %v)", i, have, err, "example.com", nil)
+		}
+	}
+	if have, want := l.GetOr("localhost"), "example.com"; have != want {
+		t.Errorf("have GetOr(%q) = %q, want %q", "localhost", have, want)
+	}
+	if !l.IsComputed() {
+		t.Error("expected IsComputed to return true after Get")
+	}
+	if calls != 1 {
+		t.Errorf("have %d calls, want %d", calls, 1)
+	}
+
+	l.Reset()
+	if l.IsComputed() {
+		t.Error("expected IsComputed to return false after Reset")
+	}
+	l.Get()
+	if calls != 2 {
+		t.Errorf("have %d calls after Reset, want %d", calls, 2)
+	}
+}
+
+func TestLazyError(t *testing.T) {
+	errNotFound := errors.New("not found")
+	calls := 0
+	l := NewLazy(func() (int, error) {
+		calls++
+		return 0, errNotFound
+	})
+
+	for i := 0; i < 2; i++ {
+		if have, err := l.Get(); !errors.Is(err, errNotFound) || have != 0 {
+			t.Fatalf("#%d: have Get() = (%v, %v), want (%v, %v)", i, have, err, 0, errNotFound)
+		}
+	}
+	if have, want := l.GetOr(42), 42; have != want {
+		t.Errorf("have GetOr(%d) = %d, want %d", 42, have, want)
+	}
+	if calls != 1 {
+		t.Errorf("have %d calls, want %d", calls, 1)
+	}
+}
+
+func TestLazyConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() (int, error) {
+		calls.Add(1)
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if have := l.GetOr(0); have != 42 {
+				t.Errorf("have GetOr(0) = %d, want %d", have, 42)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if have := calls.Load(); have != 1 {
+		t.Errorf("have %d calls, want %d", have, 1)
+	}
+}
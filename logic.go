@@ -0,0 +1,82 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// The logical functions below implement Kleene's three-valued logic,
+// as used by SQL: a nil *bool represents UNKNOWN. E.g. And(nil, false)
+// is false, as the result is false regardless of the unknown value,
+// while And(nil, true) is UNKNOWN, i.e. nil.
+//
+// The functions never return one of their arguments but a pointer
+// to a new value.
+
+// And returns the logical conjunction of a and b.
+func And(a, b *bool) *bool {
+	return AllOf(a, b)
+}
+
+// Or returns the logical disjunction of a and b.
+func Or(a, b *bool) *bool {
+	return AnyOf(a, b)
+}
+
+// Not returns the negation of a, or nil if a is nil.
+func Not(a *bool) *bool {
+	if a == nil {
+		return nil
+	}
+	return BoolPtr(!*a)
+}
+
+// Xor returns the exclusive disjunction of a and b,
+// or nil if a or b is nil.
+func Xor(a, b *bool) *bool {
+	if a == nil || b == nil {
+		return nil
+	}
+	return BoolPtr(*a != *b)
+}
+
+// Implies returns the material implication of a and b,
+// i.e. Or(Not(a), b).
+func Implies(a, b *bool) *bool {
+	return Or(Not(a), b)
+}
+
+// AllOf returns false if any of vs is false, nil if any of vs is nil,
+// and true otherwise. It returns true if vs is empty.
+func AllOf(vs ...*bool) *bool {
+	unknown := false
+	for _, v := range vs {
+		switch {
+		case v == nil:
+			unknown = true
+		case !*v:
+			return BoolPtr(false)
+		}
+	}
+	if unknown {
+		return nil
+	}
+	return BoolPtr(true)
+}
+
+// AnyOf returns true if any of vs is true, nil if any of vs is nil,
+// and false otherwise. It returns false if vs is empty.
+func AnyOf(vs ...*bool) *bool {
+	unknown := false
+	for _, v := range vs {
+		switch {
+		case v == nil:
+			unknown = true
+		case *v:
+			return BoolPtr(true)
+		}
+	}
+	if unknown {
+		return nil
+	}
+	return BoolPtr(false)
+}
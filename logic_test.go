@@ -0,0 +1,91 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"testing"
+)
+
+func TestThreeValuedLogic(t *testing.T) {
+	tr := BoolPtr(true)
+	f := BoolPtr(false)
+	tests := []struct {
+		A, B    *bool
+		And     *bool
+		Or      *bool
+		Xor     *bool
+		Implies *bool
+	}{
+		{A: tr, B: tr, And: tr, Or: tr, Xor: f, Implies: tr},
+		{A: tr, B: f, And: f, Or: tr, Xor: tr, Implies: f},
+		{A: tr, B: nil, And: nil, Or: tr, Xor: nil, Implies: nil},
+		{A: f, B: tr, And: f, Or: tr, Xor: tr, Implies: tr},
+		{A: f, B: f, And: f, Or: f, Xor: f, Implies: tr},
+		{A: f, B: nil, And: f, Or: nil, Xor: nil, Implies: tr},
+		{A: nil, B: tr, And: nil, Or: tr, Xor: nil, Implies: tr},
+		{A: nil, B: f, And: f, Or: nil, Xor: nil, Implies: nil},
+		{A: nil, B: nil, And: nil, Or: nil, Xor: nil, Implies: nil},
+	}
+
+	for i, tt := range tests {
+		if have, want := And(tt.A, tt.B), tt.And; !Equal(have, want) {
+			t.Errorf("#%d: have And(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+		if have, want := Or(tt.A, tt.B), tt.Or; !Equal(have, want) {
+			t.Errorf("#%d: have Or(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+		if have, want := Xor(tt.A, tt.B), tt.Xor; !Equal(have, want) {
+			t.Errorf("#%d: have Xor(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+		if have, want := Implies(tt.A, tt.B), tt.Implies; !Equal(have, want) {
+			t.Errorf("#%d: have Implies(%v, %v) = %v, want %v", i, tt.A, tt.B, have, want)
+		}
+	}
+}
+
+func TestNot(t *testing.T) {
+	tests := []struct {
+		Input  *bool
+		Output *bool
+	}{
+		{Input: nil, Output: nil},
+		{Input: BoolPtr(true), Output: BoolPtr(false)},
+		{Input: BoolPtr(false), Output: BoolPtr(true)},
+	}
+
+	for i, tt := range tests {
+		if have, want := Not(tt.Input), tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have Not(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestAllOfAnyOf(t *testing.T) {
+	tr := BoolPtr(true)
+	f := BoolPtr(false)
+	tests := []struct {
+		Input []*bool
+		AllOf *bool
+		AnyOf *bool
+	}{
+		{Input: nil, AllOf: tr, AnyOf: f},
+		{Input: []*bool{tr, tr}, AllOf: tr, AnyOf: tr},
+		{Input: []*bool{tr, f}, AllOf: f, AnyOf: tr},
+		{Input: []*bool{f, f}, AllOf: f, AnyOf: f},
+		{Input: []*bool{tr, nil}, AllOf: nil, AnyOf: tr},
+		{Input: []*bool{f, nil}, AllOf: f, AnyOf: nil},
+		{Input: []*bool{nil, nil}, AllOf: nil, AnyOf: nil},
+		{Input: []*bool{nil, tr, f}, AllOf: f, AnyOf: tr},
+	}
+
+	for i, tt := range tests {
+		if have, want := AllOf(tt.Input...), tt.AllOf; !Equal(have, want) {
+			t.Errorf("#%d: have AllOf(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have, want := AnyOf(tt.Input...), tt.AnyOf; !Equal(have, want) {
+			t.Errorf("#%d: have AnyOf(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
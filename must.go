@@ -0,0 +1,47 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// NilError is the error returned by Expect and the panic value of Must.
+// It wraps ErrNil.
+type NilError struct {
+	// Name describes the value that is nil, e.g. a field name.
+	Name string
+}
+
+// Error returns the error message.
+func (e *NilError) Error() string {
+	if e.Name == "" {
+		return ErrNil.Error()
+	}
+	return "nullable: " + e.Name + " is nil"
+}
+
+// Unwrap returns ErrNil.
+func (e *NilError) Unwrap() error {
+	return ErrNil
+}
+
+// Must returns *v if v is not nil. Otherwise it panics with
+// a *NilError. Name describes v in the panic message.
+//
+// Use Must where v being nil is a bug. Use Expect where it is
+// an error.
+func Must[T any](v *T, name string) T {
+	if v == nil {
+		panic(&NilError{Name: name})
+	}
+	return *v
+}
+
+// Expect returns *v if v is not nil. Otherwise it returns an empty
+// value and a *NilError. Name describes v in the error message.
+func Expect[T any](v *T, name string) (T, error) {
+	if v == nil {
+		var zero T
+		return zero, &NilError{Name: name}
+	}
+	return *v, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	if have, want := Must(IntPtr(42), "port"), 42; have != want {
+		t.Errorf("have Must(%d) = %d, want %d", 42, have, want)
+	}
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("have panic value %v, want error", r)
+		}
+		var nilErr *NilError
+		if !errors.As(err, &nilErr) || nilErr.Name != "port" {
+			t.Errorf("have panic value %v, want *NilError for %q", err, "port")
+		}
+		if !errors.Is(err, ErrNil) {
+			t.Errorf("expected panic value %v to match ErrNil", err)
+		}
+		if have, want := err.Error(), "nullable: port is nil"; have != want {
+			t.Errorf("have Error() = %q, want %q", have, want)
+		}
+	}()
+	Must[int](nil, "port")
+	t.Fatal("expected Must(nil) to panic")
+}
+
+func TestExpect(t *testing.T) {
+	tests := []struct {
+		Input  *string
+		Name   string
+		Output string
+		Err    string
+	}{
+		{StringPtr("x"), "host", "x", ""},
+		{StringPtr(""), "host", "", ""},
+		{nil, "host", "", "nullable: host is nil"},
+		{nil, "", "", "nullable: value is nil"},
+	}
+
+	for i, tt := range tests {
+		have, err := Expect(tt.Input, tt.Name)
+		if have != tt.Output {
+			t.Errorf("#%d: have Expect(%v, %q) = %q, want %q", i, tt.Input, tt.Name, have, tt.Output)
+		}
+		if tt.Err == "" {
+			if err != nil {
+				t.Errorf("#%d: have Expect(%v, %q) error %v, want %v", i, tt.Input, tt.Name, err, nil)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.Err {
+			t.Errorf("#%d: have Expect(%v, %q) error %v, want %q", i, tt.Input, tt.Name, err, tt.Err)
+		}
+		if !errors.Is(err, ErrNil) {
+			t.Errorf("#%d: expected error %v to match ErrNil", i, err)
+		}
+	}
+}
+
+func TestExpectWrapped(t *testing.T) {
+	_, err := Expect[int](nil, "config.Port")
+	err = fmt.Errorf("loading config: %w", err)
+	if !errors.Is(err, ErrNil) {
+		t.Errorf("expected wrapped error %v to match ErrNil", err)
+	}
+	if errors.Is(err, ErrOverflow) {
+		t.Errorf("expected wrapped error %v to not match ErrOverflow", err)
+	}
+	var nilErr *NilError
+	if !errors.As(err, &nilErr) || nilErr.Name != "config.Port" {
+		t.Errorf("have errors.As(%v) = %v, want *NilError for %q", err, nilErr, "config.Port")
+	}
+}
@@ -4,7 +4,10 @@
 
 package nullable
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // -- Int --
 
@@ -50,6 +53,32 @@ func IntPtrSlice(src []int) []*int {
 	return dst
 }
 
+// AppendIntSlice appends the values of the int pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// its zero value. Unlike IntSlice, it reuses the capacity of dst.
+func AppendIntSlice(dst []int, src []*int) []int {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// AppendIntPtrSlice appends pointers to the int values in src to dst
+// and returns the extended slice. Unlike IntPtrSlice, it reuses the
+// capacity of dst.
+func AppendIntPtrSlice(dst []*int, src []int) []*int {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- Int32 --
 
 // Int32 returns *v if v is not nil. Otherwise it returns 0.
@@ -92,6 +121,32 @@ func Int32PtrSlice(src []int32) []*int32 {
 	return dst
 }
 
+// AppendInt32Slice appends the values of the int32 pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// its zero value. Unlike Int32Slice, it reuses the capacity of dst.
+func AppendInt32Slice(dst []int32, src []*int32) []int32 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// AppendInt32PtrSlice appends pointers to the int32 values in src to dst
+// and returns the extended slice. Unlike Int32PtrSlice, it reuses the
+// capacity of dst.
+func AppendInt32PtrSlice(dst []*int32, src []int32) []*int32 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- Int64 --
 
 // Int64 returns *v if v is not nil. Otherwise it returns 0.
@@ -134,6 +189,32 @@ func Int64PtrSlice(src []int64) []*int64 {
 	return dst
 }
 
+// AppendInt64Slice appends the values of the int64 pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// its zero value. Unlike Int64Slice, it reuses the capacity of dst.
+func AppendInt64Slice(dst []int64, src []*int64) []int64 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// AppendInt64PtrSlice appends pointers to the int64 values in src to dst
+// and returns the extended slice. Unlike Int64PtrSlice, it reuses the
+// capacity of dst.
+func AppendInt64PtrSlice(dst []*int64, src []int64) []*int64 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- Float32 --
 
 // Float32 returns *v if v is not nil. Otherwise it returns 0.
@@ -176,6 +257,32 @@ func Float32PtrSlice(src []float32) []*float32 {
 	return dst
 }
 
+// AppendFloat32Slice appends the values of the float32 pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// its zero value. Unlike Float32Slice, it reuses the capacity of dst.
+func AppendFloat32Slice(dst []float32, src []*float32) []float32 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// AppendFloat32PtrSlice appends pointers to the float32 values in src to dst
+// and returns the extended slice. Unlike Float32PtrSlice, it reuses the
+// capacity of dst.
+func AppendFloat32PtrSlice(dst []*float32, src []float32) []*float32 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- Float64 --
 
 // Float64 returns *v if v is not nil. Otherwise it returns 0.
@@ -218,6 +325,32 @@ func Float64PtrSlice(src []float64) []*float64 {
 	return dst
 }
 
+// AppendFloat64Slice appends the values of the float64 pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// its zero value. Unlike Float64Slice, it reuses the capacity of dst.
+func AppendFloat64Slice(dst []float64, src []*float64) []float64 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, 0)
+		}
+	}
+	return dst
+}
+
+// AppendFloat64PtrSlice appends pointers to the float64 values in src to dst
+// and returns the extended slice. Unlike Float64PtrSlice, it reuses the
+// capacity of dst.
+func AppendFloat64PtrSlice(dst []*float64, src []float64) []*float64 {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- String --
 
 // String returns *v if v is not nil. Otherwise it returns "".
@@ -262,6 +395,32 @@ func StringPtrSlice(src []string) []*string {
 	return dst
 }
 
+// AppendStringSlice appends the values of the string pointers in src to dst
+// and returns the extended slice. Elements that are nil are appended as
+// empty strings. Unlike StringSlice, it reuses the capacity of dst.
+func AppendStringSlice(dst []string, src []*string) []string {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		if v := src[i]; v != nil {
+			dst = append(dst, *v)
+		} else {
+			dst = append(dst, "")
+		}
+	}
+	return dst
+}
+
+// AppendStringPtrSlice appends pointers to the string values in src to dst
+// and returns the extended slice. Unlike StringPtrSlice, it reuses the
+// capacity of dst.
+func AppendStringPtrSlice(dst []*string, src []string) []*string {
+	dst = slices.Grow(dst, len(src))
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, &(src[i]))
+	}
+	return dst
+}
+
 // -- Bool --
 
 // Bool returns *v if v is not nil. Otherwise it returns fase.
@@ -123,6 +123,72 @@ func TestIntPtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendIntSlice(t *testing.T) {
+	one := int(1)
+	two := int(2)
+	tests := []struct {
+		Dst    []int
+		Input  []*int
+		Output []int
+	}{
+		{
+			Dst:    nil,
+			Input:  []*int{&one, nil, &two},
+			Output: []int{one, 0, two},
+		},
+		{
+			Dst:    []int{two},
+			Input:  []*int{&one},
+			Output: []int{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendIntSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendIntSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendIntSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendIntPtrSlice(t *testing.T) {
+	one := int(1)
+	two := int(2)
+	tests := []struct {
+		Dst    []*int
+		Input  []int
+		Output []*int
+	}{
+		{
+			Dst:    nil,
+			Input:  []int{one, two},
+			Output: []*int{&one, &two},
+		},
+		{
+			Dst:    []*int{&two},
+			Input:  []int{one},
+			Output: []*int{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendIntPtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendIntPtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendIntPtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- Int32 --
 
 func TestInt32(t *testing.T) {
@@ -217,6 +283,72 @@ func TestInt32PtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendInt32Slice(t *testing.T) {
+	one := int32(1)
+	two := int32(2)
+	tests := []struct {
+		Dst    []int32
+		Input  []*int32
+		Output []int32
+	}{
+		{
+			Dst:    nil,
+			Input:  []*int32{&one, nil, &two},
+			Output: []int32{one, 0, two},
+		},
+		{
+			Dst:    []int32{two},
+			Input:  []*int32{&one},
+			Output: []int32{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendInt32Slice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendInt32Slice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendInt32Slice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendInt32PtrSlice(t *testing.T) {
+	one := int32(1)
+	two := int32(2)
+	tests := []struct {
+		Dst    []*int32
+		Input  []int32
+		Output []*int32
+	}{
+		{
+			Dst:    nil,
+			Input:  []int32{one, two},
+			Output: []*int32{&one, &two},
+		},
+		{
+			Dst:    []*int32{&two},
+			Input:  []int32{one},
+			Output: []*int32{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendInt32PtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendInt32PtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendInt32PtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- Int64 --
 
 func TestInt64(t *testing.T) {
@@ -311,6 +443,72 @@ func TestInt64PtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendInt64Slice(t *testing.T) {
+	one := int64(1)
+	two := int64(2)
+	tests := []struct {
+		Dst    []int64
+		Input  []*int64
+		Output []int64
+	}{
+		{
+			Dst:    nil,
+			Input:  []*int64{&one, nil, &two},
+			Output: []int64{one, 0, two},
+		},
+		{
+			Dst:    []int64{two},
+			Input:  []*int64{&one},
+			Output: []int64{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendInt64Slice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendInt64Slice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendInt64Slice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendInt64PtrSlice(t *testing.T) {
+	one := int64(1)
+	two := int64(2)
+	tests := []struct {
+		Dst    []*int64
+		Input  []int64
+		Output []*int64
+	}{
+		{
+			Dst:    nil,
+			Input:  []int64{one, two},
+			Output: []*int64{&one, &two},
+		},
+		{
+			Dst:    []*int64{&two},
+			Input:  []int64{one},
+			Output: []*int64{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendInt64PtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendInt64PtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendInt64PtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- Float32 --
 
 func TestFloat32(t *testing.T) {
@@ -405,6 +603,72 @@ func TestFloat32PtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendFloat32Slice(t *testing.T) {
+	one := float32(1.5)
+	two := float32(2.5)
+	tests := []struct {
+		Dst    []float32
+		Input  []*float32
+		Output []float32
+	}{
+		{
+			Dst:    nil,
+			Input:  []*float32{&one, nil, &two},
+			Output: []float32{one, 0, two},
+		},
+		{
+			Dst:    []float32{two},
+			Input:  []*float32{&one},
+			Output: []float32{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendFloat32Slice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendFloat32Slice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendFloat32Slice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendFloat32PtrSlice(t *testing.T) {
+	one := float32(1.5)
+	two := float32(2.5)
+	tests := []struct {
+		Dst    []*float32
+		Input  []float32
+		Output []*float32
+	}{
+		{
+			Dst:    nil,
+			Input:  []float32{one, two},
+			Output: []*float32{&one, &two},
+		},
+		{
+			Dst:    []*float32{&two},
+			Input:  []float32{one},
+			Output: []*float32{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendFloat32PtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendFloat32PtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendFloat32PtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- Float64 --
 
 func TestFloat64(t *testing.T) {
@@ -499,6 +763,72 @@ func TestFloat64PtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendFloat64Slice(t *testing.T) {
+	one := float64(1.5)
+	two := float64(2.5)
+	tests := []struct {
+		Dst    []float64
+		Input  []*float64
+		Output []float64
+	}{
+		{
+			Dst:    nil,
+			Input:  []*float64{&one, nil, &two},
+			Output: []float64{one, 0, two},
+		},
+		{
+			Dst:    []float64{two},
+			Input:  []*float64{&one},
+			Output: []float64{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendFloat64Slice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendFloat64Slice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendFloat64Slice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendFloat64PtrSlice(t *testing.T) {
+	one := float64(1.5)
+	two := float64(2.5)
+	tests := []struct {
+		Dst    []*float64
+		Input  []float64
+		Output []*float64
+	}{
+		{
+			Dst:    nil,
+			Input:  []float64{one, two},
+			Output: []*float64{&one, &two},
+		},
+		{
+			Dst:    []*float64{&two},
+			Input:  []float64{one},
+			Output: []*float64{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendFloat64PtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendFloat64PtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendFloat64PtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- String --
 func TestString(t *testing.T) {
 	one := "one"
@@ -612,6 +942,72 @@ func TestStringPtrSlice(t *testing.T) {
 	}
 }
 
+func TestAppendStringSlice(t *testing.T) {
+	one := string("one")
+	two := string("two")
+	tests := []struct {
+		Dst    []string
+		Input  []*string
+		Output []string
+	}{
+		{
+			Dst:    nil,
+			Input:  []*string{&one, nil, &two},
+			Output: []string{one, "", two},
+		},
+		{
+			Dst:    []string{two},
+			Input:  []*string{&one},
+			Output: []string{two, one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendStringSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendStringSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have AppendStringSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestAppendStringPtrSlice(t *testing.T) {
+	one := string("one")
+	two := string("two")
+	tests := []struct {
+		Dst    []*string
+		Input  []string
+		Output []*string
+	}{
+		{
+			Dst:    nil,
+			Input:  []string{one, two},
+			Output: []*string{&one, &two},
+		},
+		{
+			Dst:    []*string{&two},
+			Input:  []string{one},
+			Output: []*string{&two, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := AppendStringPtrSlice(tt.Dst, tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(AppendStringPtrSlice(%v, %v)) = %d, want %d", i, tt.Dst, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := *have[j], *want[j]; x != y {
+				t.Errorf("#%d: have AppendStringPtrSlice(%v, %v)[%d] = %v, want %v", i, tt.Dst, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
 // -- Bool --
 
 func TestBool(t *testing.T) {
@@ -741,3 +1137,66 @@ func TestDurationWithDefault(t *testing.T) {
 		}
 	}
 }
+
+// -- Append --
+
+func TestAppendSliceDoesNotAllocate(t *testing.T) {
+	src := make([]*int, 1000)
+	for i := 0; i < len(src); i++ {
+		if i%10 != 0 {
+			src[i] = IntPtr(i)
+		}
+	}
+	values := IntSlice(src)
+
+	var dst []int
+	var ptrs []*int
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = AppendIntSlice(dst[:0], src)
+		ptrs = AppendIntPtrSlice(ptrs[:0], values)
+	})
+	// The first run allocates the buffers, which AllocsPerRun ignores.
+	if allocs != 0 {
+		t.Errorf("have %v allocations in steady state, want 0", allocs)
+	}
+}
+
+func BenchmarkIntSlice(b *testing.B) {
+	src := make([]*int, 1000)
+	for i := 0; i < len(src); i++ {
+		src[i] = IntPtr(i)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = IntSlice(src)
+	}
+}
+
+func BenchmarkAppendIntSlice(b *testing.B) {
+	src := make([]*int, 1000)
+	for i := 0; i < len(src); i++ {
+		src[i] = IntPtr(i)
+	}
+	var dst []int
+	b.ReportAllocs()
+	for b.Loop() {
+		dst = AppendIntSlice(dst[:0], src)
+	}
+}
+
+func BenchmarkStringPtrSlice(b *testing.B) {
+	src := make([]string, 1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = StringPtrSlice(src)
+	}
+}
+
+func BenchmarkAppendStringPtrSlice(b *testing.B) {
+	src := make([]string, 1000)
+	var dst []*string
+	b.ReportAllocs()
+	for b.Loop() {
+		dst = AppendStringPtrSlice(dst[:0], src)
+	}
+}
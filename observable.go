@@ -0,0 +1,105 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"slices"
+	"sync"
+)
+
+// Change describes a change of an Observable. Old is nil if the
+// value was unset before, New is nil if it has been cleared.
+type Change[T any] struct {
+	Old *T
+	New *T
+}
+
+// Observable is an optional value that notifies subscribers
+// when it changes. The zero value is unset and has no subscribers.
+//
+// An Observable is safe for concurrent use.
+// It must not be copied after first use.
+type Observable[T comparable] struct {
+	mu   sync.Mutex
+	v    *T
+	subs []chan Change[T]
+}
+
+// Get returns a pointer to a copy of the value if it is set.
+// Otherwise it returns nil.
+func (o *Observable[T]) Get() *T {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return clone(o.v)
+}
+
+// Set sets the value to v.
+func (o *Observable[T]) Set(v T) {
+	o.set(&v)
+}
+
+// Clear unsets the value.
+func (o *Observable[T]) Clear() {
+	o.set(nil)
+}
+
+func (o *Observable[T]) set(v *T) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if Equal(o.v, v) {
+		return
+	}
+	old := o.v
+	o.v = v
+	for _, ch := range o.subs {
+		notify(ch, Change[T]{Old: clone(old), New: clone(v)})
+	}
+}
+
+// notify sends c to ch without blocking. If the buffer of ch is full,
+// it drops the oldest change. It must be called with the mutex of the
+// Observable held, so there is no other sender.
+func notify[T any](ch chan Change[T], c Change[T]) {
+	for {
+		select {
+		case ch <- c:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel that receives all subsequent changes of
+// the value, in order, and a function that cancels the subscription
+// and closes the channel.
+//
+// Changes are buffered up to buf changes (at least 1). If the
+// subscriber does not keep up, the oldest changes are dropped, so
+// Set and Clear never block on slow subscribers. The New field of
+// the last change received always reflects the current value.
+func (o *Observable[T]) Subscribe(buf int) (<-chan Change[T], func()) {
+	ch := make(chan Change[T], max(buf, 1))
+
+	o.mu.Lock()
+	o.subs = append(o.subs, ch)
+	o.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+			o.subs = slices.DeleteFunc(o.subs, func(c chan Change[T]) bool {
+				return c == ch
+			})
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
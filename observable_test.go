@@ -0,0 +1,144 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestObservable(t *testing.T) {
+	var o Observable[int]
+	ch, unsubscribe := o.Subscribe(10)
+	defer unsubscribe()
+
+	o.Set(1)
+	o.Set(1) // unchanged
+	o.Set(2)
+	o.Clear()
+	o.Clear() // unchanged
+	o.Set(3)
+
+	if have := o.Get(); have == nil || *have != 3 {
+		t.Fatalf("have Get() = %v, want %d", have, 3)
+	}
+
+	want := []Change[int]{
+		{nil, IntPtr(1)},
+		{IntPtr(1), IntPtr(2)},
+		{IntPtr(2), nil},
+		{nil, IntPtr(3)},
+	}
+	for i, w := range want {
+		have := <-ch
+		if !Equal(have.Old, w.Old) || !Equal(have.New, w.New) {
+			t.Errorf("#%d: have change %v -> %v, want %v -> %v", i, have.Old, have.New, w.Old, w.New)
+		}
+	}
+	select {
+	case c := <-ch:
+		t.Errorf("unexpected change %v -> %v", c.Old, c.New)
+	default:
+	}
+}
+
+func TestObservableGetCopies(t *testing.T) {
+	var o Observable[int]
+	if have := o.Get(); have != nil {
+		t.Fatalf("have Get() = %v, want %v", *have, nil)
+	}
+	o.Set(1)
+	*o.Get() = 2
+	if have := o.Get(); *have != 1 {
+		t.Errorf("have Get() = %d, want %d", *have, 1)
+	}
+}
+
+func TestObservableDropsOldest(t *testing.T) {
+	var o Observable[int]
+	ch, unsubscribe := o.Subscribe(2)
+	defer unsubscribe()
+
+	for i := 1; i <= 5; i++ {
+		o.Set(i)
+	}
+
+	want := []Change[int]{
+		{IntPtr(3), IntPtr(4)},
+		{IntPtr(4), IntPtr(5)},
+	}
+	for i, w := range want {
+		have := <-ch
+		if !Equal(have.Old, w.Old) || !Equal(have.New, w.New) {
+			t.Errorf("#%d: have change %v -> %v, want %v -> %v", i, have.Old, have.New, w.Old, w.New)
+		}
+	}
+}
+
+func TestObservableUnsubscribe(t *testing.T) {
+	var o Observable[string]
+	ch1, unsubscribe1 := o.Subscribe(1)
+	ch2, unsubscribe2 := o.Subscribe(1)
+	defer unsubscribe2()
+
+	unsubscribe1()
+	unsubscribe1() // no-op
+
+	o.Set("a")
+	if c, ok := <-ch1; ok {
+		t.Errorf("have change %v -> %v on closed subscription, want closed channel", c.Old, c.New)
+	}
+	if c := <-ch2; !Equal(c.New, StringPtr("a")) {
+		t.Errorf("have change to %v, want %q", c.New, "a")
+	}
+}
+
+func TestObservableConcurrent(t *testing.T) {
+	var o Observable[int]
+	const writers = 4
+	const sets = 100
+
+	var wg sync.WaitGroup
+	for g := 0; g < writers; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < sets; i++ {
+				o.Set(g*sets + i)
+				_ = o.Get()
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			ch, unsubscribe := o.Subscribe(4)
+			for i := 0; i < sets/10; i++ {
+				select {
+				case <-ch:
+				default:
+				}
+			}
+			unsubscribe()
+			for range ch {
+			}
+		}()
+	}
+
+	// Within a subscription, each change starts where the previous one ended
+	ch, unsubscribe := o.Subscribe(writers * sets)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var prev *Change[int]
+		for c := range ch {
+			if prev != nil && !Equal(prev.New, c.Old) {
+				t.Errorf("have change from %v after change to %v", c.Old, prev.New)
+			}
+			prev = &c
+		}
+	}()
+	wg.Wait()
+	unsubscribe()
+	<-done
+}
@@ -0,0 +1,45 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+// FromOK returns a pointer to v if ok is true. Otherwise it returns nil.
+// Use it with map lookups and type assertions, e.g.
+//
+//	v, ok := ports[name]
+//	port := nullable.FromOK(v, ok)
+func FromOK[T any](v T, ok bool) *T {
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// ToOK returns *v and true if v is not nil.
+// Otherwise it returns an empty value and false.
+func ToOK[T any](v *T) (T, bool) {
+	if v == nil {
+		var zero T
+		return zero, false
+	}
+	return *v, true
+}
+
+// FromErr returns a pointer to v if err is nil. Otherwise it returns nil,
+// dropping err. Use it with parsers where an invalid value should be
+// treated like a missing one, e.g.
+//
+//	n := nullable.IntWithDefault(nullable.FromErr(strconv.Atoi(s)), 10)
+func FromErr[T any](v T, err error) *T {
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// Try returns a pointer to the value returned by f if f returns no error.
+// Otherwise it returns nil.
+func Try[T any](f func() (T, error)) *T {
+	return FromErr(f())
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFromOK(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	tests := []struct {
+		Key    string
+		Output *int
+	}{
+		{"a", IntPtr(1)},
+		{"zero", IntPtr(0)},
+		{"missing", nil},
+	}
+
+	for i, tt := range tests {
+		v, ok := m[tt.Key]
+		if have := FromOK(v, ok); !Equal(have, tt.Output) {
+			t.Errorf("#%d: have FromOK(m[%q]) = %v, want %v", i, tt.Key, have, tt.Output)
+		}
+	}
+
+	var x any = "s"
+	s, ok := x.(string)
+	if have := FromOK(s, ok); !Equal(have, StringPtr("s")) {
+		t.Errorf("have FromOK(x.(string)) = %v, want %q", have, "s")
+	}
+	n, ok := x.(int)
+	if have := FromOK(n, ok); have != nil {
+		t.Errorf("have FromOK(x.(int)) = %v, want %v", *have, nil)
+	}
+}
+
+func TestToOK(t *testing.T) {
+	tests := []struct {
+		Input  *int
+		Output int
+		OK     bool
+	}{
+		{nil, 0, false},
+		{IntPtr(0), 0, true},
+		{IntPtr(42), 42, true},
+	}
+
+	for i, tt := range tests {
+		have, ok := ToOK(tt.Input)
+		if have != tt.Output || ok != tt.OK {
+			t.Errorf("#%d: have ToOK(%v) = (%v, %v), want (%v, %v)", i, tt.Input, have, ok, tt.Output, tt.OK)
+		}
+	}
+}
+
+func TestFromErr(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output *int
+	}{
+		{"42", IntPtr(42)},
+		{"0", IntPtr(0)},
+		{"", nil},
+		{"x", nil},
+	}
+
+	for i, tt := range tests {
+		if have := FromErr(strconv.Atoi(tt.Input)); !Equal(have, tt.Output) {
+			t.Errorf("#%d: have FromErr(strconv.Atoi(%q)) = %v, want %v", i, tt.Input, have, tt.Output)
+		}
+	}
+
+	if have, want := IntWithDefault(FromErr(strconv.Atoi("x")), 10), 10; have != want {
+		t.Errorf("have IntWithDefault(FromErr(...), 10) = %v, want %v", have, want)
+	}
+}
+
+func TestTry(t *testing.T) {
+	if have := Try(func() (string, error) { return "ok", nil }); !Equal(have, StringPtr("ok")) {
+		t.Errorf("have Try(...) = %v, want %q", have, "ok")
+	}
+	if have := Try(func() (string, error) { return "ignored", errors.New("fail") }); have != nil {
+		t.Errorf("have Try(...) = %q, want %v", *have, nil)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The ParseX functions parse a string into a pointer to a value,
+// e.g. to read optional columns of a CSV file. The empty string,
+// as well as any of the optional null tokens like "NULL", "N/A" or "-",
+// is parsed as nil. All other strings are parsed with the corresponding
+// function of package strconv or time, and its error is returned
+// if parsing fails.
+
+// ParseInt parses s as a base 10 int. It returns nil if s is empty
+// or one of nulls.
+func ParseInt(s string, nulls ...string) (*int, error) {
+	return parse(s, nulls, strconv.Atoi)
+}
+
+// ParseInt32 parses s as a base 10 int32. It returns nil if s is empty
+// or one of nulls.
+func ParseInt32(s string, nulls ...string) (*int32, error) {
+	return parse(s, nulls, func(s string) (int32, error) {
+		v, err := strconv.ParseInt(s, 10, 32)
+		return int32(v), err
+	})
+}
+
+// ParseInt64 parses s as a base 10 int64. It returns nil if s is empty
+// or one of nulls.
+func ParseInt64(s string, nulls ...string) (*int64, error) {
+	return parse(s, nulls, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+}
+
+// ParseFloat32 parses s as a float32. It returns nil if s is empty
+// or one of nulls.
+func ParseFloat32(s string, nulls ...string) (*float32, error) {
+	return parse(s, nulls, func(s string) (float32, error) {
+		v, err := strconv.ParseFloat(s, 32)
+		return float32(v), err
+	})
+}
+
+// ParseFloat64 parses s as a float64. It returns nil if s is empty
+// or one of nulls.
+func ParseFloat64(s string, nulls ...string) (*float64, error) {
+	return parse(s, nulls, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// ParseBool parses s with strconv.ParseBool. It returns nil if s is empty
+// or one of nulls.
+func ParseBool(s string, nulls ...string) (*bool, error) {
+	return parse(s, nulls, strconv.ParseBool)
+}
+
+// ParseDuration parses s with time.ParseDuration. It returns nil if s
+// is empty or one of nulls.
+func ParseDuration(s string, nulls ...string) (*time.Duration, error) {
+	return parse(s, nulls, time.ParseDuration)
+}
+
+// ParseTime parses s with time.Parse and the given layout. It returns nil
+// if s is empty or one of nulls.
+func ParseTime(layout, s string, nulls ...string) (*time.Time, error) {
+	return parse(s, nulls, func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+}
+
+func parse[T any](s string, nulls []string, f func(string) (T, error)) (*T, error) {
+	if s == "" || slices.Contains(nulls, s) {
+		return nil, nil
+	}
+	v, err := f(s)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *int
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "0", Output: IntPtr(0)},
+		{Input: "-42", Output: IntPtr(-42)},
+		{Input: "NULL", Output: nil, Error: true},
+		{Input: "NULL", Nulls: []string{"NULL", "N/A"}, Output: nil},
+		{Input: "N/A", Nulls: []string{"NULL", "N/A"}, Output: nil},
+		{Input: "null", Nulls: []string{"NULL"}, Output: nil, Error: true},
+		{Input: " 1", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseInt(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseInt(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseInt(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseInt32(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *int32
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "-", Nulls: []string{"-"}, Output: nil},
+		{Input: "2147483647", Output: Int32Ptr(2147483647)},
+		{Input: "2147483648", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseInt32(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseInt32(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseInt32(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseInt64(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *int64
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "-", Nulls: []string{"-"}, Output: nil},
+		{Input: "9223372036854775807", Output: Int64Ptr(9223372036854775807)},
+		{Input: "9223372036854775808", Output: nil, Error: true},
+		{Input: "1.5", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseInt64(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseInt64(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseInt64(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseFloat32(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *float32
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "N/A", Nulls: []string{"N/A"}, Output: nil},
+		{Input: "1.5", Output: Float32Ptr(1.5)},
+		{Input: "1e39", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseFloat32(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseFloat32(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseFloat32(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseFloat64(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *float64
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "N/A", Nulls: []string{"N/A"}, Output: nil},
+		{Input: "3.25", Output: Float64Ptr(3.25)},
+		{Input: "-1e3", Output: Float64Ptr(-1000)},
+		{Input: "three", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseFloat64(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseFloat64(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseFloat64(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *bool
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "NULL", Nulls: []string{"NULL"}, Output: nil},
+		{Input: "true", Output: BoolPtr(true)},
+		{Input: "0", Output: BoolPtr(false)},
+		{Input: "yes", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseBool(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseBool(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseBool(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Nulls  []string
+		Output *time.Duration
+		Error  bool
+	}{
+		{Input: "", Output: nil},
+		{Input: "-", Nulls: []string{"-"}, Output: nil},
+		{Input: "1m3s", Output: DurationPtr(63 * time.Second)},
+		{Input: "63", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseDuration(tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseDuration(%q) error = %v, want error = %v", i, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !Equal(have, want) {
+			t.Errorf("#%d: have ParseDuration(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		Layout string
+		Input  string
+		Nulls  []string
+		Output *time.Time
+		Error  bool
+	}{
+		{Layout: time.DateOnly, Input: "", Output: nil},
+		{Layout: time.DateOnly, Input: "0000-00-00", Nulls: []string{"0000-00-00"}, Output: nil},
+		{Layout: time.DateOnly, Input: "2017-01-02", Output: TimePtr(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC))},
+		{Layout: time.RFC3339, Input: "2017-01-02T12:14:59+01:00", Output: TimePtr(time.Date(2017, 1, 2, 11, 14, 59, 0, time.UTC))},
+		{Layout: time.RFC3339, Input: "2017-01-02", Output: nil, Error: true},
+	}
+
+	for i, tt := range tests {
+		have, err := ParseTime(tt.Layout, tt.Input, tt.Nulls...)
+		if (err != nil) != tt.Error {
+			t.Fatalf("#%d: have ParseTime(%q, %q) error = %v, want error = %v", i, tt.Layout, tt.Input, err, tt.Error)
+		}
+		if want := tt.Output; !TimeEqual(have, want) {
+			t.Errorf("#%d: have ParseTime(%q, %q) = %v, want %v", i, tt.Layout, tt.Input, have, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"context"
+	"sync"
+)
+
+// Promise is an optional value that is set at most once, typically
+// by another goroutine, and that can be waited for. The zero value
+// is an unset Promise ready to use.
+//
+// A Promise must not be copied after first use.
+type Promise[T any] struct {
+	mu   sync.Mutex
+	done chan struct{}
+	v    *T
+}
+
+// doneChan returns the channel that is closed when the value is set.
+// It must be called with p.mu held.
+func (p *Promise[T]) doneChan() chan struct{} {
+	if p.done == nil {
+		p.done = make(chan struct{})
+	}
+	return p.done
+}
+
+// Set sets the value to v if it is not set yet, and wakes up all
+// goroutines waiting for it. It reports whether the value has been set;
+// if it returns false, the Promise is left unchanged.
+func (p *Promise[T]) Set(v T) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.v != nil {
+		return false
+	}
+	p.v = &v
+	close(p.doneChan())
+	return true
+}
+
+// Wait blocks until the value is set or ctx is done. It returns
+// the value, or an empty value and the error of ctx.
+func (p *Promise[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-p.Done():
+	case <-ctx.Done():
+		// Prefer the value if both are ready
+		if v := p.TryGet(); v != nil {
+			return *v, nil
+		}
+		var v T
+		return v, ctx.Err()
+	}
+	return *p.TryGet(), nil
+}
+
+// TryGet returns a pointer to a copy of the value if it is set.
+// Otherwise it returns nil. It does not block.
+func (p *Promise[T]) TryGet() *T {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return clone(p.v)
+}
+
+// Done returns a channel that is closed when the value is set.
+func (p *Promise[T]) Done() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.doneChan()
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPromise(t *testing.T) {
+	var p Promise[int]
+
+	if have := p.TryGet(); have != nil {
+		t.Fatalf("have TryGet() = %v, want %v", *have, nil)
+	}
+	select {
+	case <-p.Done():
+		t.Fatal("expected Done to not be closed before Set")
+	default:
+	}
+
+	if have := p.Set(8080); !have {
+		t.Fatalf("have Set(%d) = %v, want %v", 8080, have, true)
+	}
+	if have := p.Set(9090); have {
+		t.Fatalf("have second Set(%d) = %v, want %v", 9090, have, false)
+	}
+
+	select {
+	case <-p.Done():
+	default:
+		t.Fatal("expected Done to be closed after Set")
+	}
+	if have := p.TryGet(); have == nil || *have != 8080 {
+		t.Fatalf("have TryGet() = %v, want %d", have, 8080)
+	}
+	*p.TryGet() = 1
+	if have, err := p.Wait(context.Background()); err != nil || have != 8080 {
+		t.Fatalf("have Wait() = (%v, %v), want (%d, %v)", have, err, 8080, nil)
+	}
+}
+
+func TestPromiseWait(t *testing.T) {
+	var p Promise[string]
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		p.Set("localhost:8080")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	have, err := p.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "localhost:8080"; have != want {
+		t.Errorf("have Wait() = %q, want %q", have, want)
+	}
+}
+
+func TestPromiseWaitCanceled(t *testing.T) {
+	var p Promise[int]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if have, err := p.Wait(ctx); !errors.Is(err, context.Canceled) || have != 0 {
+		t.Errorf("have Wait() = (%v, %v), want (%d, %v)", have, err, 0, context.Canceled)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if have, err := p.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) || have != 0 {
+		t.Errorf("have Wait() = (%v, %v), want (%d, %v)", have, err, 0, context.DeadlineExceeded)
+	}
+
+	// A value that is already set wins over a canceled context
+	p.Set(1)
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if have, err := p.Wait(ctx); err != nil || have != 1 {
+		t.Errorf("have Wait() = (%v, %v), want (%d, %v)", have, err, 1, nil)
+	}
+}
+
+func TestPromiseConcurrentSet(t *testing.T) {
+	var p Promise[int]
+	const goroutines = 16
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		winners []int
+	)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			if p.Set(g) {
+				mu.Lock()
+				winners = append(winners, g)
+				mu.Unlock()
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			if _, err := p.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(winners) != 1 {
+		t.Fatalf("have %d successful calls to Set, want %d", len(winners), 1)
+	}
+	if have := p.TryGet(); have == nil || *have != winners[0] {
+		t.Errorf("have TryGet() = %v, want %d", have, winners[0])
+	}
+}
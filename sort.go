@@ -0,0 +1,72 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// SortNilsFirst sorts s in ascending order, with nil elements first.
+// The sort is not guaranteed to be stable.
+func SortNilsFirst[T cmp.Ordered](s []*T) {
+	slices.SortFunc(s, comparePtrs[T](NilsFirst))
+}
+
+// SortNilsLast sorts s in ascending order, with nil elements last.
+// The sort is not guaranteed to be stable.
+func SortNilsLast[T cmp.Ordered](s []*T) {
+	slices.SortFunc(s, comparePtrs[T](NilsLast))
+}
+
+// SortStableNilsFirst is like SortNilsFirst but keeps the original
+// order of equal elements.
+func SortStableNilsFirst[T cmp.Ordered](s []*T) {
+	slices.SortStableFunc(s, comparePtrs[T](NilsFirst))
+}
+
+// SortStableNilsLast is like SortNilsLast but keeps the original
+// order of equal elements.
+func SortStableNilsLast[T cmp.Ordered](s []*T) {
+	slices.SortStableFunc(s, comparePtrs[T](NilsLast))
+}
+
+// SortTimeNilsFirst sorts s in ascending order, with nil elements first.
+// Times are compared with time.Time.Compare. The sort is stable.
+func SortTimeNilsFirst(s []*time.Time) {
+	slices.SortStableFunc(s, func(a, b *time.Time) int { return TimeCompare(a, b, NilsFirst) })
+}
+
+// SortTimeNilsLast sorts s in ascending order, with nil elements last.
+// Times are compared with time.Time.Compare. The sort is stable.
+func SortTimeNilsLast(s []*time.Time) {
+	slices.SortStableFunc(s, func(a, b *time.Time) int { return TimeCompare(a, b, NilsLast) })
+}
+
+// CompareBy returns a comparison function for values of type S that
+// compares the optional field returned by f with Compare. It can be
+// used with slices.SortFunc to sort structs by an optional field, e.g.
+//
+//	slices.SortFunc(books, nullable.CompareBy(func(b Book) *int { return b.Year }, nullable.NilsLast))
+func CompareBy[S any, T cmp.Ordered](f func(S) *T, order NilOrder) func(a, b S) int {
+	return func(a, b S) int {
+		return Compare(f(a), f(b), order)
+	}
+}
+
+// CompareTimeBy is like CompareBy but compares the times
+// with TimeCompare.
+func CompareTimeBy[S any](f func(S) *time.Time, order NilOrder) func(a, b S) int {
+	return func(a, b S) int {
+		return TimeCompare(f(a), f(b), order)
+	}
+}
+
+func comparePtrs[T cmp.Ordered](order NilOrder) func(a, b *T) int {
+	return func(a, b *T) int {
+		return Compare(a, b, order)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSortNilsFirst(t *testing.T) {
+	tests := []struct {
+		Input  []*string
+		Output []*string
+	}{
+		{Input: nil, Output: nil},
+		{
+			Input:  []*string{StringPtr("b"), nil, StringPtr("c"), StringPtr("a"), nil},
+			Output: []*string{nil, nil, StringPtr("a"), StringPtr("b"), StringPtr("c")},
+		},
+	}
+
+	for i, tt := range tests {
+		s := slices.Clone(tt.Input)
+		SortNilsFirst(s)
+		if !SliceEqual(s, tt.Output) {
+			t.Errorf("#%d: have SortNilsFirst(%v) = %v, want %v", i, tt.Input, s, tt.Output)
+		}
+	}
+}
+
+func TestSortNilsLast(t *testing.T) {
+	tests := []struct {
+		Input  []*int
+		Output []*int
+	}{
+		{Input: nil, Output: nil},
+		{
+			Input:  []*int{IntPtr(2), nil, IntPtr(3), IntPtr(1), nil},
+			Output: []*int{IntPtr(1), IntPtr(2), IntPtr(3), nil, nil},
+		},
+	}
+
+	for i, tt := range tests {
+		s := slices.Clone(tt.Input)
+		SortNilsLast(s)
+		if !SliceEqual(s, tt.Output) {
+			t.Errorf("#%d: have SortNilsLast(%v) = %v, want %v", i, tt.Input, s, tt.Output)
+		}
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	a1, a2, b := StringPtr("a"), StringPtr("a"), StringPtr("b")
+
+	s := []*string{b, a1, nil, a2}
+	SortStableNilsFirst(s)
+	if want := []*string{nil, a1, a2, b}; !slices.Equal(s, want) {
+		t.Errorf("have SortStableNilsFirst = %v, want %v", s, want)
+	}
+
+	s = []*string{b, a2, nil, a1}
+	SortStableNilsLast(s)
+	if want := []*string{a2, a1, b, nil}; !slices.Equal(s, want) {
+		t.Errorf("have SortStableNilsLast = %v, want %v", s, want)
+	}
+}
+
+func TestSortTime(t *testing.T) {
+	one := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	two := time.Date(1982, 11, 23, 23, 11, 9, 0, time.UTC)
+	three := time.Date(2017, 1, 2, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	s := []*time.Time{&one, nil, &two, &three}
+	SortTimeNilsFirst(s)
+	if want := []*time.Time{nil, &two, &three, &one}; !slices.Equal(s, want) {
+		t.Errorf("have SortTimeNilsFirst = %v, want %v", s, want)
+	}
+
+	s = []*time.Time{&one, nil, &two, &three}
+	SortTimeNilsLast(s)
+	if want := []*time.Time{&two, &three, &one, nil}; !slices.Equal(s, want) {
+		t.Errorf("have SortTimeNilsLast = %v, want %v", s, want)
+	}
+}
+
+func TestCompareBy(t *testing.T) {
+	type book struct {
+		Title     string
+		Year      *int
+		Published *time.Time
+	}
+	books := []book{
+		{Title: "C", Year: IntPtr(2001)},
+		{Title: "A"},
+		{Title: "B", Year: IntPtr(1997)},
+	}
+	titles := func(books []book) string {
+		var s string
+		for _, b := range books {
+			s += b.Title
+		}
+		return s
+	}
+
+	slices.SortFunc(books, CompareBy(func(b book) *int { return b.Year }, NilsLast))
+	if have, want := titles(books), "BCA"; have != want {
+		t.Errorf("have CompareBy(Year, NilsLast) order %s, want %s", have, want)
+	}
+
+	slices.SortFunc(books, CompareBy(func(b book) *int { return b.Year }, NilsFirst))
+	if have, want := titles(books), "ABC"; have != want {
+		t.Errorf("have CompareBy(Year, NilsFirst) order %s, want %s", have, want)
+	}
+
+	books[0].Published = TimePtr(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC))
+	books[2].Published = TimePtr(time.Date(1982, 11, 23, 0, 0, 0, 0, time.UTC))
+	slices.SortFunc(books, CompareTimeBy(func(b book) *time.Time { return b.Published }, NilsLast))
+	if have, want := titles(books), "CAB"; have != want {
+		t.Errorf("have CompareTimeBy(Published, NilsLast) order %s, want %s", have, want)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Vector is a sequence of optional values of type T. Unlike []*T, which
+// needs a pointer and a separately allocated value per element, a Vector
+// stores its values contiguously, plus a validity bitmap with one bit
+// per element that tells whether the element is null, like the columnar
+// format of Apache Arrow.
+//
+// The zero value is an empty Vector ready to use.
+// A Vector is not safe for concurrent use.
+type Vector[T any] struct {
+	values   []T
+	validity []uint64 // bit i is set if element i is not null
+	nulls    int
+}
+
+// VectorFrom returns a Vector with the values of src.
+// Elements of src that are nil are null in the Vector.
+func VectorFrom[T any](src []*T) *Vector[T] {
+	v := &Vector[T]{
+		values:   make([]T, len(src)),
+		validity: make([]uint64, (len(src)+63)/64),
+	}
+	for i, p := range src {
+		if p != nil {
+			v.values[i] = *p
+			v.validity[i/64] |= 1 << (i % 64)
+		} else {
+			v.nulls++
+		}
+	}
+	return v
+}
+
+// Len returns the number of elements in v, including nulls.
+func (v *Vector[T]) Len() int {
+	return len(v.values)
+}
+
+// NullCount returns the number of null elements in v.
+func (v *Vector[T]) NullCount() int {
+	return v.nulls
+}
+
+// IsNull returns true if element i is null.
+// It panics if i is out of range.
+func (v *Vector[T]) IsNull(i int) bool {
+	_ = v.values[i] // bounds check
+	return v.validity[i/64]&(1<<(i%64)) == 0
+}
+
+// Get returns the value of element i and true, or the zero value
+// and false if element i is null. It panics if i is out of range.
+func (v *Vector[T]) Get(i int) (T, bool) {
+	if v.IsNull(i) {
+		var zero T
+		return zero, false
+	}
+	return v.values[i], true
+}
+
+// Ptr returns a pointer to the value of element i, or nil if element i
+// is null. The pointer points into the storage of v, i.e. it is only
+// valid until v is modified by Append or AppendNull.
+// It panics if i is out of range.
+func (v *Vector[T]) Ptr(i int) *T {
+	if v.IsNull(i) {
+		return nil
+	}
+	return &v.values[i]
+}
+
+// Set sets element i to x. It panics if i is out of range.
+func (v *Vector[T]) Set(i int, x T) {
+	if v.IsNull(i) {
+		v.validity[i/64] |= 1 << (i % 64)
+		v.nulls--
+	}
+	v.values[i] = x
+}
+
+// SetNull sets element i to null. It panics if i is out of range.
+func (v *Vector[T]) SetNull(i int) {
+	if !v.IsNull(i) {
+		v.validity[i/64] &^= 1 << (i % 64)
+		v.nulls++
+	}
+	var zero T
+	v.values[i] = zero
+}
+
+// Append appends x to v.
+func (v *Vector[T]) Append(x T) {
+	i := v.grow()
+	v.values[i] = x
+	v.validity[i/64] |= 1 << (i % 64)
+}
+
+// AppendNull appends a null element to v.
+func (v *Vector[T]) AppendNull() {
+	v.grow()
+	v.nulls++
+}
+
+// grow appends a null element and returns its index.
+func (v *Vector[T]) grow() int {
+	var zero T
+	i := len(v.values)
+	v.values = append(v.values, zero)
+	if i/64 == len(v.validity) {
+		v.validity = append(v.validity, 0)
+	}
+	return i
+}
+
+// PtrSlice returns the elements of v as a slice of pointers, with nil
+// for null elements. Like e.g. IntPtrSlice, the pointers point into
+// the storage of v.
+func (v *Vector[T]) PtrSlice() []*T {
+	dst := make([]*T, len(v.values))
+	for i := 0; i < len(v.values); i++ {
+		dst[i] = v.Ptr(i)
+	}
+	return dst
+}
+
+// All returns an iterator over the indexes and elements of v, with nil
+// for null elements. Like Ptr, the pointers point into the storage of v.
+func (v *Vector[T]) All() iter.Seq2[int, *T] {
+	return func(yield func(int, *T) bool) {
+		for i := 0; i < len(v.values); i++ {
+			if !yield(i, v.Ptr(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the elements of v
+// that are not null.
+func (v *Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for w, word := range v.validity {
+			for word != 0 {
+				i := w*64 + bits.TrailingZeros64(word)
+				if !yield(v.values[i]) {
+					return
+				}
+				word &= word - 1 // clear lowest set bit
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVectorFrom(t *testing.T) {
+	tests := []struct {
+		Input []*float64
+		Len   int
+		Nulls int
+	}{
+		{Input: nil, Len: 0, Nulls: 0},
+		{Input: []*float64{nil}, Len: 1, Nulls: 1},
+		{Input: []*float64{Float64Ptr(1.5), nil, Float64Ptr(2.5)}, Len: 3, Nulls: 1},
+	}
+
+	for i, tt := range tests {
+		v := VectorFrom(tt.Input)
+		if have, want := v.Len(), tt.Len; have != want {
+			t.Errorf("#%d: have Len() = %d, want %d", i, have, want)
+		}
+		if have, want := v.NullCount(), tt.Nulls; have != want {
+			t.Errorf("#%d: have NullCount() = %d, want %d", i, have, want)
+		}
+		if have, want := v.PtrSlice(), tt.Input; !SliceEqual(have, want) {
+			t.Errorf("#%d: have PtrSlice() = %v, want %v", i, have, want)
+		}
+	}
+}
+
+func TestVectorGetSet(t *testing.T) {
+	var v Vector[int]
+	for i := 0; i < 130; i++ {
+		if i%3 == 0 {
+			v.AppendNull()
+		} else {
+			v.Append(i)
+		}
+	}
+	if have, want := v.Len(), 130; have != want {
+		t.Fatalf("have Len() = %d, want %d", have, want)
+	}
+	if have, want := v.NullCount(), 44; have != want {
+		t.Fatalf("have NullCount() = %d, want %d", have, want)
+	}
+	for i := 0; i < v.Len(); i++ {
+		x, ok := v.Get(i)
+		if ok != (i%3 != 0) {
+			t.Errorf("have Get(%d) ok = %v, want %v", i, ok, i%3 != 0)
+		}
+		if ok && x != i {
+			t.Errorf("have Get(%d) = %d, want %d", i, x, i)
+		}
+		if have, want := v.IsNull(i), i%3 == 0; have != want {
+			t.Errorf("have IsNull(%d) = %v, want %v", i, have, want)
+		}
+	}
+
+	v.Set(0, 42)
+	v.Set(1, 43)
+	v.SetNull(2)
+	v.SetNull(3)
+	if x, ok := v.Get(0); !ok || x != 42 {
+		t.Errorf("have Get(0) = %d, %v, want %d, true", x, ok, 42)
+	}
+	if x, ok := v.Get(1); !ok || x != 43 {
+		t.Errorf("have Get(1) = %d, %v, want %d, true", x, ok, 43)
+	}
+	if x, ok := v.Get(2); ok || x != 0 {
+		t.Errorf("have Get(2) = %d, %v, want %d, false", x, ok, 0)
+	}
+	if have, want := v.NullCount(), 44; have != want {
+		t.Errorf("have NullCount() = %d, want %d", have, want)
+	}
+	if have := v.Ptr(3); have != nil {
+		t.Errorf("have Ptr(3) = %v, want nil", have)
+	}
+	if have := v.Ptr(1); have == nil || *have != 43 {
+		t.Errorf("have Ptr(1) = %v, want %d", have, 43)
+	}
+}
+
+func TestVectorOutOfRange(t *testing.T) {
+	v := VectorFrom([]*int{IntPtr(1)})
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected Get(1) to panic")
+		}
+	}()
+	v.Get(1)
+}
+
+func TestVectorIterators(t *testing.T) {
+	input := []*string{StringPtr("a"), nil, StringPtr("b")}
+	v := VectorFrom(input)
+
+	if have, want := slices.Collect(v.Values()), []string{"a", "b"}; !slices.Equal(have, want) {
+		t.Errorf("have Values() = %v, want %v", have, want)
+	}
+
+	var (
+		indexes []int
+		ptrs    []*string
+	)
+	for i, p := range v.All() {
+		indexes = append(indexes, i)
+		ptrs = append(ptrs, p)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(indexes, want) {
+		t.Errorf("have All() indexes = %v, want %v", indexes, want)
+	}
+	if !SliceEqual(ptrs, input) {
+		t.Errorf("have All() elements = %v, want %v", ptrs, input)
+	}
+
+	for x := range v.Values() {
+		if x != "a" {
+			t.Errorf("have Values() = %q after break, want %q", x, "a")
+		}
+		break
+	}
+}
+
+func newBenchmarkInput(n int) []*float64 {
+	src := make([]*float64, n)
+	for i := 0; i < n; i++ {
+		if i%10 != 0 {
+			src[i] = Float64Ptr(float64(i))
+		}
+	}
+	return src
+}
+
+func BenchmarkSliceSum(b *testing.B) {
+	src := newBenchmarkInput(100000)
+	b.ReportAllocs()
+	for b.Loop() {
+		var sum float64
+		for _, p := range src {
+			if p != nil {
+				sum += *p
+			}
+		}
+		_ = sum
+	}
+}
+
+func BenchmarkVectorSum(b *testing.B) {
+	v := VectorFrom(newBenchmarkInput(100000))
+	b.ReportAllocs()
+	for b.Loop() {
+		var sum float64
+		for x := range v.Values() {
+			sum += x
+		}
+		_ = sum
+	}
+}
+
+func BenchmarkSliceBuild(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var s []*float64
+		for i := 0; i < 100000; i++ {
+			if i%10 == 0 {
+				s = append(s, nil)
+			} else {
+				s = append(s, Float64Ptr(float64(i)))
+			}
+		}
+	}
+}
+
+func BenchmarkVectorBuild(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var v Vector[float64]
+		for i := 0; i < 100000; i++ {
+			if i%10 == 0 {
+				v.AppendNull()
+			} else {
+				v.Append(float64(i))
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"strings"
+	"time"
+)
+
+// NilIfZero returns nil if v is nil or points to the zero value of T.
+// Otherwise it returns v. Values with an IsZero method, like time.Time,
+// are zero if IsZero returns true.
+func NilIfZero[T comparable](v *T) *T {
+	if v == nil || isZero(*v) {
+		return nil
+	}
+	return v
+}
+
+// PtrIfNonZero returns nil if v is the zero value of T.
+// Otherwise it returns a pointer to v. Values with an IsZero method,
+// like time.Time, are zero if IsZero returns true.
+func PtrIfNonZero[T comparable](v T) *T {
+	if isZero(v) {
+		return nil
+	}
+	return &v
+}
+
+// NilIfZeroSlice converts a slice of pointers to a slice of pointers
+// where all elements that point to a zero value are nil.
+func NilIfZeroSlice[T comparable](src []*T) []*T {
+	dst := make([]*T, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = NilIfZero(src[i])
+	}
+	return dst
+}
+
+// PtrIfNonZeroSlice converts a slice of values to a slice of pointers
+// where all zero values are nil. Like e.g. IntPtrSlice, the non-nil
+// pointers point into src.
+func PtrIfNonZeroSlice[T comparable](src []T) []*T {
+	dst := make([]*T, len(src))
+	for i := 0; i < len(src); i++ {
+		if !isZero(src[i]) {
+			dst[i] = &(src[i])
+		}
+	}
+	return dst
+}
+
+// NilIfBlank returns nil if v is nil or *v is empty or consists
+// of whitespace only. Otherwise it returns v.
+func NilIfBlank(v *string) *string {
+	if v == nil || strings.TrimSpace(*v) == "" {
+		return nil
+	}
+	return v
+}
+
+// NilIfBlankSlice converts a slice of string pointers to a slice
+// of string pointers where all blank elements are nil.
+func NilIfBlankSlice(src []*string) []*string {
+	dst := make([]*string, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = NilIfBlank(src[i])
+	}
+	return dst
+}
+
+// IntPtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func IntPtrIfNonZero(v int) *int {
+	return PtrIfNonZero(v)
+}
+
+// Int32PtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func Int32PtrIfNonZero(v int32) *int32 {
+	return PtrIfNonZero(v)
+}
+
+// Int64PtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func Int64PtrIfNonZero(v int64) *int64 {
+	return PtrIfNonZero(v)
+}
+
+// Float32PtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func Float32PtrIfNonZero(v float32) *float32 {
+	return PtrIfNonZero(v)
+}
+
+// Float64PtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func Float64PtrIfNonZero(v float64) *float64 {
+	return PtrIfNonZero(v)
+}
+
+// StringPtrIfNonZero returns nil if v is "". Otherwise it returns a pointer to v.
+func StringPtrIfNonZero(v string) *string {
+	return PtrIfNonZero(v)
+}
+
+// StringPtrIfNonBlank returns nil if v is empty or consists of whitespace
+// only. Otherwise it returns a pointer to v.
+func StringPtrIfNonBlank(v string) *string {
+	return NilIfBlank(&v)
+}
+
+// BoolPtrIfNonZero returns nil if v is false. Otherwise it returns a pointer to v.
+func BoolPtrIfNonZero(v bool) *bool {
+	return PtrIfNonZero(v)
+}
+
+// TimePtrIfNonZero returns nil if v.IsZero(). Otherwise it returns a pointer to v.
+func TimePtrIfNonZero(v time.Time) *time.Time {
+	return PtrIfNonZero(v)
+}
+
+// DurationPtrIfNonZero returns nil if v is 0. Otherwise it returns a pointer to v.
+func DurationPtrIfNonZero(v time.Duration) *time.Duration {
+	return PtrIfNonZero(v)
+}
@@ -0,0 +1,200 @@
+// Copyright 2017 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package nullable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNilIfZero(t *testing.T) {
+	zero := 0
+	one := 1
+	tests := []struct {
+		Input  *int
+		Output *int
+	}{
+		{Input: nil, Output: nil},
+		{Input: &zero, Output: nil},
+		{Input: &one, Output: &one},
+	}
+
+	for i, tt := range tests {
+		if have, want := NilIfZero(tt.Input), tt.Output; have != want {
+			t.Errorf("#%d: have NilIfZero(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestNilIfZeroTime(t *testing.T) {
+	var zero time.Time
+	zeroCET := zero.In(time.FixedZone("CET", 3600))
+	one := time.Date(2017, 1, 2, 12, 14, 59, 0, time.UTC)
+	tests := []struct {
+		Input  *time.Time
+		Output *time.Time
+	}{
+		{Input: nil, Output: nil},
+		{Input: &zero, Output: nil},
+		{Input: &zeroCET, Output: nil},
+		{Input: &one, Output: &one},
+	}
+
+	for i, tt := range tests {
+		if have, want := NilIfZero(tt.Input), tt.Output; have != want {
+			t.Errorf("#%d: have NilIfZero(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestPtrIfNonZero(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Output *string
+	}{
+		{Input: "", Output: nil},
+		{Input: " ", Output: StringPtr(" ")},
+		{Input: "one", Output: StringPtr("one")},
+	}
+
+	for i, tt := range tests {
+		have, want := PtrIfNonZero(tt.Input), tt.Output
+		if !Equal(have, want) {
+			t.Errorf("#%d: have PtrIfNonZero(%q) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestTypedPtrIfNonZero(t *testing.T) {
+	var zeroTime time.Time
+	now := time.Now()
+	tests := []struct {
+		Name   string
+		IsNil  bool
+		Output bool
+	}{
+		{Name: "IntPtrIfNonZero(0)", IsNil: IntPtrIfNonZero(0) == nil, Output: true},
+		{Name: "IntPtrIfNonZero(1)", IsNil: IntPtrIfNonZero(1) == nil, Output: false},
+		{Name: "Int32PtrIfNonZero(0)", IsNil: Int32PtrIfNonZero(0) == nil, Output: true},
+		{Name: "Int32PtrIfNonZero(1)", IsNil: Int32PtrIfNonZero(1) == nil, Output: false},
+		{Name: "Int64PtrIfNonZero(0)", IsNil: Int64PtrIfNonZero(0) == nil, Output: true},
+		{Name: "Int64PtrIfNonZero(1)", IsNil: Int64PtrIfNonZero(1) == nil, Output: false},
+		{Name: "Float32PtrIfNonZero(0)", IsNil: Float32PtrIfNonZero(0) == nil, Output: true},
+		{Name: "Float32PtrIfNonZero(0.5)", IsNil: Float32PtrIfNonZero(0.5) == nil, Output: false},
+		{Name: "Float64PtrIfNonZero(0)", IsNil: Float64PtrIfNonZero(0) == nil, Output: true},
+		{Name: "Float64PtrIfNonZero(0.5)", IsNil: Float64PtrIfNonZero(0.5) == nil, Output: false},
+		{Name: `StringPtrIfNonZero("")`, IsNil: StringPtrIfNonZero("") == nil, Output: true},
+		{Name: `StringPtrIfNonZero(" ")`, IsNil: StringPtrIfNonZero(" ") == nil, Output: false},
+		{Name: `StringPtrIfNonBlank(" \t")`, IsNil: StringPtrIfNonBlank(" \t") == nil, Output: true},
+		{Name: `StringPtrIfNonBlank(" a ")`, IsNil: StringPtrIfNonBlank(" a ") == nil, Output: false},
+		{Name: "BoolPtrIfNonZero(false)", IsNil: BoolPtrIfNonZero(false) == nil, Output: true},
+		{Name: "BoolPtrIfNonZero(true)", IsNil: BoolPtrIfNonZero(true) == nil, Output: false},
+		{Name: "TimePtrIfNonZero(zero)", IsNil: TimePtrIfNonZero(zeroTime) == nil, Output: true},
+		{Name: "TimePtrIfNonZero(now)", IsNil: TimePtrIfNonZero(now) == nil, Output: false},
+		{Name: "DurationPtrIfNonZero(0)", IsNil: DurationPtrIfNonZero(0) == nil, Output: true},
+		{Name: "DurationPtrIfNonZero(1s)", IsNil: DurationPtrIfNonZero(time.Second) == nil, Output: false},
+	}
+
+	for i, tt := range tests {
+		if have, want := tt.IsNil, tt.Output; have != want {
+			t.Errorf("#%d: have %s == nil = %v, want %v", i, tt.Name, have, want)
+		}
+	}
+}
+
+func TestNilIfBlank(t *testing.T) {
+	empty := ""
+	blank := " \t\n"
+	one := " one "
+	tests := []struct {
+		Input  *string
+		Output *string
+	}{
+		{Input: nil, Output: nil},
+		{Input: &empty, Output: nil},
+		{Input: &blank, Output: nil},
+		{Input: &one, Output: &one},
+	}
+
+	for i, tt := range tests {
+		if have, want := NilIfBlank(tt.Input), tt.Output; have != want {
+			t.Errorf("#%d: have NilIfBlank(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+	}
+}
+
+func TestNilIfZeroSlice(t *testing.T) {
+	zero := 0
+	one := 1
+	tests := []struct {
+		Input  []*int
+		Output []*int
+	}{
+		{
+			Input:  []*int{nil, &zero, &one},
+			Output: []*int{nil, nil, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := NilIfZeroSlice(tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(NilIfZeroSlice(%v)) = %d, want %d", i, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have NilIfZeroSlice(%v)[%d] = %v, want %v", i, tt.Input, j, x, y)
+			}
+		}
+	}
+}
+
+func TestPtrIfNonZeroSlice(t *testing.T) {
+	tests := []struct {
+		Input  []string
+		Output []*string
+	}{
+		{
+			Input:  []string{"", "one"},
+			Output: []*string{nil, StringPtr("one")},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := PtrIfNonZeroSlice(tt.Input), tt.Output
+		if !SliceEqual(have, want) {
+			t.Errorf("#%d: have PtrIfNonZeroSlice(%v) = %v, want %v", i, tt.Input, have, want)
+		}
+		if have[1] != &tt.Input[1] {
+			t.Errorf("#%d: expected PtrIfNonZeroSlice(%v)[1] to point into the input", i, tt.Input)
+		}
+	}
+}
+
+func TestNilIfBlankSlice(t *testing.T) {
+	blank := " "
+	one := "one"
+	tests := []struct {
+		Input  []*string
+		Output []*string
+	}{
+		{
+			Input:  []*string{nil, &blank, &one},
+			Output: []*string{nil, nil, &one},
+		},
+	}
+
+	for i, tt := range tests {
+		have, want := NilIfBlankSlice(tt.Input), tt.Output
+		if haveLen, wantLen := len(have), len(want); haveLen != wantLen {
+			t.Fatalf("#%d: have len(NilIfBlankSlice(%v)) = %d, want %d", i, tt.Input, haveLen, wantLen)
+		}
+		for j := 0; j < len(have); j++ {
+			if x, y := have[j], want[j]; x != y {
+				t.Errorf("#%d: have NilIfBlankSlice(%v)[%d] = %v, want %v", i, tt.Input, j, x, y)
+			}
+		}
+	}
+}